@@ -11,3 +11,20 @@ Usage:
     {{- tpl (.value | toYaml) .context }}
   {{- end }}
 {{- end -}}
+
+{{/*
+Name of the MinIO service of the Tenant. Tenants created when only one Tenant was allowed per namespace keep
+their `minio` service.
+*/}}
+{{- define "minio-tenant.serviceName" -}}
+  {{- $name := .Values.tenant.name }}
+  {{- $legacy := lookup "v1" "Service" .Release.Namespace "minio" }}
+  {{- if $legacy }}
+    {{- range $legacy.metadata.ownerReferences }}
+      {{- if and (eq .kind "Tenant") (eq .name $name) }}
+        {{- $name = "minio" }}
+      {{- end }}
+    {{- end }}
+  {{- end }}
+  {{- $name }}
+{{- end -}}
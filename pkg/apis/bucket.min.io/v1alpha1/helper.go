@@ -0,0 +1,61 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BucketFinalizer lets the MinIO Operator apply the deletion policy of a Bucket before it's gone
+const BucketFinalizer = "min.io/bucket-cleanup"
+
+// BucketConditionReady indicates the bucket in MinIO matches the spec of the Bucket
+const BucketConditionReady = "Ready"
+
+// BucketName returns the name of the bucket in MinIO
+func (b *Bucket) BucketName() string {
+	if b.Spec.Name != "" {
+		return b.Spec.Name
+	}
+	return b.Name
+}
+
+// DeletesBucket returns true if the bucket is deleted from MinIO along with the Bucket resource
+func (b *Bucket) DeletesBucket() bool {
+	return b.Spec.DeletionPolicy == DeletionPolicyDelete
+}
+
+// Validate returns an error if the settings of the bucket can't be applied together
+func (b *Bucket) Validate() error {
+	if b.Spec.Tenant.Name == "" {
+		return errors.New("tenant name is empty")
+	}
+	if b.Status.Name != "" && b.Status.Name != b.BucketName() {
+		return fmt.Errorf("the bucket was created as '%s', its name can't be changed to '%s'", b.Status.Name, b.BucketName())
+	}
+	if retention := b.Spec.Retention; retention != nil {
+		if !b.Spec.ObjectLocking {
+			return errors.New("retention requires objectLocking")
+		}
+		if (retention.Days > 0) == (retention.Years > 0) {
+			return errors.New("retention must set either days or years")
+		}
+	}
+	if b.Spec.ObjectLocking && b.Spec.Versioning == VersioningSuspended {
+		return errors.New("versioning can't be suspended on a bucket with objectLocking")
+	}
+	return nil
+}
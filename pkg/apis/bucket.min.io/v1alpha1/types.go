@@ -0,0 +1,237 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VersioningState is the versioning state of a bucket
+type VersioningState string
+
+const (
+	// VersioningEnabled keeps every version of the objects of the bucket
+	VersioningEnabled VersioningState = "Enabled"
+	// VersioningSuspended stops creating new versions of the objects of the bucket
+	VersioningSuspended VersioningState = "Suspended"
+)
+
+// RetentionMode is the object lock retention mode applied by default to the new objects of a bucket
+type RetentionMode string
+
+const (
+	// RetentionGovernance lets users with special permissions override the retention of an object
+	RetentionGovernance RetentionMode = "GOVERNANCE"
+	// RetentionCompliance prevents any user from overriding the retention of an object
+	RetentionCompliance RetentionMode = "COMPLIANCE"
+)
+
+// AnonymousAccess is the access granted to anonymous users on a bucket
+type AnonymousAccess string
+
+const (
+	// AnonymousNone doesn't allow anonymous access to the bucket
+	AnonymousNone AnonymousAccess = "none"
+	// AnonymousDownload allows anonymous users to list and download the objects of the bucket
+	AnonymousDownload AnonymousAccess = "download"
+	// AnonymousUpload allows anonymous users to upload objects to the bucket
+	AnonymousUpload AnonymousAccess = "upload"
+	// AnonymousPublic allows anonymous users to list, download and upload objects
+	AnonymousPublic AnonymousAccess = "public"
+)
+
+// DeletionPolicy is what happens to a bucket in MinIO when its Bucket resource is deleted
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyRetain keeps the bucket and its objects
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyDelete deletes the bucket once it's empty
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=mbucket,singular=bucket
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenant.name`
+// +kubebuilder:printcolumn:name="Bucket",type=string,JSONPath=`.status.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:annotations=operator.min.io/version=v6.0.2
+
+// Bucket is a top-level type. A client is created for it
+type Bucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// *Required* +
+	//
+	// The root field for the Bucket object.
+	Spec BucketSpec `json:"spec"`
+
+	// Status provides details of the state of the bucket in MinIO
+	// +optional
+	Status BucketStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BucketList is a top-level list type.
+type BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Bucket `json:"items"`
+}
+
+// BucketSpec (`spec`) defines the configuration of a bucket of a MinIO Tenant. +
+type BucketSpec struct {
+	// *Required* +
+	//
+	// The Tenant the bucket belongs to. The Tenant must be in the namespace of the Bucket. +
+	Tenant TenantReference `json:"tenant"`
+
+	// *Optional* +
+	//
+	// Name of the bucket in MinIO. Defaults to the name of the Bucket resource, and can't be changed. +
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name,omitempty"`
+
+	// *Optional* +
+	//
+	// Region of the bucket. +
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region,omitempty"`
+
+	// *Optional* +
+	//
+	// Creates the bucket with object locking enabled, which also enables its versioning. Object locking can only be enabled when the bucket is created. +
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectLocking is immutable"
+	ObjectLocking bool `json:"objectLocking,omitempty"`
+
+	// *Optional* +
+	//
+	// Versioning of the bucket, either `Enabled` or `Suspended`. The versioning of the bucket is left as it is when empty. +
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Suspended
+	Versioning VersioningState `json:"versioning,omitempty"`
+
+	// *Optional* +
+	//
+	// Retention applied by default to the new objects of the bucket. Requires `objectLocking`. +
+	// +optional
+	Retention *BucketRetention `json:"retention,omitempty"`
+
+	// *Optional* +
+	//
+	// Hard quota of the bucket, such as `100Gi`. Writes are rejected once the bucket reaches its quota. +
+	// +optional
+	Quota *resource.Quantity `json:"quota,omitempty"`
+
+	// *Optional* +
+	//
+	// Tags of the bucket. +
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// *Optional* +
+	//
+	// Access granted to anonymous users on the bucket: `none` (default), `download`, `upload` or `public`. +
+	// +optional
+	// +kubebuilder:default=none
+	// +kubebuilder:validation:Enum=none;download;upload;public
+	AnonymousAccess AnonymousAccess `json:"anonymousAccess,omitempty"`
+
+	// *Optional* +
+	//
+	// What happens to the bucket when the Bucket resource is deleted: `Retain` (default) keeps the bucket and its objects, `Delete` deletes the bucket once it's empty. +
+	// +optional
+	// +kubebuilder:default=Retain
+	// +kubebuilder:validation:Enum=Retain;Delete
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// TenantReference is the reference to the Tenant of a bucket
+type TenantReference struct {
+	// *Required* +
+	//
+	// Name of the Tenant. +
+	Name string `json:"name"`
+}
+
+// BucketRetention (`retention`) defines the default retention of the new objects of a bucket. +
+type BucketRetention struct {
+	// *Required* +
+	//
+	// Retention mode, either `GOVERNANCE` or `COMPLIANCE`. +
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode RetentionMode `json:"mode"`
+
+	// *Optional* +
+	//
+	// Days the objects are retained. Either `days` or `years` must be set. +
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Days int32 `json:"days,omitempty"`
+
+	// *Optional* +
+	//
+	// Years the objects are retained. Either `days` or `years` must be set. +
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Years int32 `json:"years,omitempty"`
+}
+
+// BucketStatus is the status of a bucket in MinIO
+type BucketStatus struct {
+	// *Optional* +
+	//
+	// Generation of the Bucket last synced to MinIO
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// *Optional* +
+	//
+	// Name of the bucket in MinIO
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// *Optional* +
+	//
+	// Conditions of the bucket, `Ready` is true once the bucket matches its spec
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// *Optional* +
+	//
+	// Settings of the bucket last found changed outside of the Bucket resource, and restored
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+
+	// *Optional* +
+	//
+	// Time the settings of the bucket were last found changed outside of the Bucket resource
+	// +optional
+	LastDriftTime *metav1.Time `json:"lastDriftTime,omitempty"`
+}
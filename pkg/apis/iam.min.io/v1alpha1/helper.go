@@ -0,0 +1,226 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package v1alpha1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	iampolicy "github.com/minio/pkg/iam/policy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IAMFinalizer lets the MinIO Operator remove an IAM resource from MinIO before it's gone
+const IAMFinalizer = "min.io/iam-cleanup"
+
+// ConditionReady indicates MinIO matches the spec of an IAM resource
+const ConditionReady = "Ready"
+
+// RotateCredentialsAnnotation rotates the generated credentials of a MinIOUser each time its value changes
+const RotateCredentialsAnnotation = "min.io/rotate-credentials"
+
+// Fields of the Secret holding the credentials of a MinIOUser, the same as the users of a Tenant
+const (
+	AccessKeyField = "CONSOLE_ACCESS_KEY"
+	SecretKeyField = "CONSOLE_SECRET_KEY"
+)
+
+// Fields of the Secret a MinIOAccessKey is written to
+const (
+	AccessKeyIDField     = "accessKey"
+	SecretAccessKeyField = "secretKey"
+	EndpointField        = "endpoint"
+	CAField              = "ca.crt"
+)
+
+// builtinPolicies are the policies MinIO comes with, they can't be replaced
+var builtinPolicies = []string{"consoleAdmin", "diagnostics", "readonly", "readwrite", "writeonly"}
+
+// PolicyName returns the name of the policy in MinIO
+func (p *MinIOPolicy) PolicyName() string {
+	if p.Spec.Name != "" {
+		return p.Spec.Name
+	}
+	return p.Name
+}
+
+// ParsePolicy returns the policy document, or an error if it isn't a valid IAM policy
+func (p *MinIOPolicy) ParsePolicy() (*iampolicy.Policy, error) {
+	return parsePolicy(p.Spec.Policy.Raw)
+}
+
+// Validate returns an error if the policy can't be created in MinIO
+func (p *MinIOPolicy) Validate() error {
+	if p.Spec.Tenant.Name == "" {
+		return errors.New("tenant name is empty")
+	}
+	name := p.PolicyName()
+	if p.Status.Name != "" && p.Status.Name != name {
+		return fmt.Errorf("the policy was created as '%s', its name can't be changed to '%s'", p.Status.Name, name)
+	}
+	for _, builtin := range builtinPolicies {
+		if name == builtin {
+			return fmt.Errorf("'%s' is a builtin policy of MinIO and can't be replaced", name)
+		}
+	}
+	if _, err := p.ParsePolicy(); err != nil {
+		return fmt.Errorf("invalid policy: %w", err)
+	}
+	return nil
+}
+
+// UserName returns the name of the user in MinIO
+func (u *MinIOUser) UserName() string {
+	if u.Spec.Name != "" {
+		return u.Spec.Name
+	}
+	return u.Name
+}
+
+// GeneratesCredentials returns true if the Operator generates the credentials of the user
+func (u *MinIOUser) GeneratesCredentials() bool {
+	return u.Spec.CredentialsSecret == nil || u.Spec.CredentialsSecret.Name == ""
+}
+
+// SecretName returns the name of the Secret holding the credentials of the user
+func (u *MinIOUser) SecretName() string {
+	if u.GeneratesCredentials() {
+		return u.Name + "-credentials"
+	}
+	return u.Spec.CredentialsSecret.Name
+}
+
+// OwnerRef returns the OwnerReference of the Secret generated for the user
+func (u *MinIOUser) OwnerRef() []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(u, SchemeGroupVersion.WithKind("MinIOUser")),
+	}
+}
+
+// Validate returns an error if the user can't be created in MinIO
+func (u *MinIOUser) Validate() error {
+	if u.Spec.Tenant.Name == "" {
+		return errors.New("tenant name is empty")
+	}
+	name := u.UserName()
+	if u.Status.Name != "" && u.Status.Name != name {
+		return fmt.Errorf("the user was created as '%s', its name can't be changed to '%s'", u.Status.Name, name)
+	}
+	if len(name) < 3 {
+		return fmt.Errorf("user name '%s' must be at least 3 characters long", name)
+	}
+	return validatePolicyNames(u.Spec.Policies)
+}
+
+// GroupName returns the name of the group in MinIO
+func (g *MinIOGroup) GroupName() string {
+	if g.Spec.Name != "" {
+		return g.Spec.Name
+	}
+	return g.Name
+}
+
+// Validate returns an error if the group can't be created in MinIO
+func (g *MinIOGroup) Validate() error {
+	if g.Spec.Tenant.Name == "" {
+		return errors.New("tenant name is empty")
+	}
+	name := g.GroupName()
+	if g.Status.Name != "" && g.Status.Name != name {
+		return fmt.Errorf("the group was created as '%s', its name can't be changed to '%s'", g.Status.Name, name)
+	}
+	for _, member := range g.Spec.Members {
+		if member == "" {
+			return errors.New("member name is empty")
+		}
+	}
+	return validatePolicyNames(g.Spec.Policies)
+}
+
+// validatePolicyNames returns an error if a policy attached to a user or group can't be a MinIO policy
+func validatePolicyNames(policies []string) error {
+	for _, policy := range policies {
+		if policy == "" || strings.Contains(policy, ",") {
+			return fmt.Errorf("invalid policy name '%s'", policy)
+		}
+	}
+	return nil
+}
+
+// SecretName returns the name of the Secret the access key is written to
+func (k *MinIOAccessKey) SecretName() string {
+	if k.Spec.SecretName != "" {
+		return k.Spec.SecretName
+	}
+	return k.Name + "-access-key"
+}
+
+// OwnerRef returns the OwnerReference of the Secret the access key is written to
+func (k *MinIOAccessKey) OwnerRef() []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(k, SchemeGroupVersion.WithKind("MinIOAccessKey")),
+	}
+}
+
+// ParsePolicy returns the policy restricting the access key, nil if it has the policies of its user
+func (k *MinIOAccessKey) ParsePolicy() (*iampolicy.Policy, error) {
+	if k.Spec.Policy == nil {
+		return nil, nil
+	}
+	return parsePolicy(k.Spec.Policy.Raw)
+}
+
+// Validate returns an error if the access key can't be created in MinIO
+func (k *MinIOAccessKey) Validate() error {
+	if k.Spec.Tenant.Name == "" {
+		return errors.New("tenant name is empty")
+	}
+	if k.Spec.User == "" {
+		return errors.New("user is empty")
+	}
+	if _, err := k.ParsePolicy(); err != nil {
+		return fmt.Errorf("invalid policy: %w", err)
+	}
+	if k.Spec.Expiry != nil && k.Spec.Expiry.Duration <= 0 {
+		return errors.New("expiry must be positive")
+	}
+	if rotation := k.Spec.Rotation; rotation != nil {
+		if rotation.Period.Duration <= 0 {
+			return errors.New("rotation period must be positive")
+		}
+		lifetime := rotation.Period.Duration
+		if rotation.GracePeriod != nil {
+			if rotation.GracePeriod.Duration < 0 {
+				return errors.New("rotation grace period can't be negative")
+			}
+			lifetime += rotation.GracePeriod.Duration
+		}
+		// the access keys would expire while they are still in use
+		if k.Spec.Expiry != nil && k.Spec.Expiry.Duration < lifetime {
+			return fmt.Errorf("expiry %s is shorter than the rotation period and its grace period", k.Spec.Expiry.Duration)
+		}
+	}
+	return nil
+}
+
+// parsePolicy returns the policy document, or an error if it isn't a valid IAM policy
+func parsePolicy(raw []byte) (*iampolicy.Policy, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("policy is empty")
+	}
+	return iampolicy.ParseConfig(bytes.NewReader(raw))
+}
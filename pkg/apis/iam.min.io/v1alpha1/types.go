@@ -0,0 +1,404 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=mpolicy,singular=miniopolicy
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenant.name`
+// +kubebuilder:printcolumn:name="Policy",type=string,JSONPath=`.status.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:annotations=operator.min.io/version=v6.0.2
+
+// MinIOPolicy is a top-level type. A client is created for it
+type MinIOPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// *Required* +
+	//
+	// The root field for the MinIOPolicy object.
+	Spec MinIOPolicySpec `json:"spec"`
+
+	// Status provides details of the state of the policy in MinIO
+	// +optional
+	Status SyncStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinIOPolicyList is a top-level list type.
+type MinIOPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MinIOPolicy `json:"items"`
+}
+
+// MinIOPolicySpec (`spec`) defines an IAM policy of a MinIO Tenant. +
+type MinIOPolicySpec struct {
+	// *Required* +
+	//
+	// The Tenant the policy belongs to. The Tenant must be in the namespace of the MinIOPolicy. +
+	Tenant TenantReference `json:"tenant"`
+
+	// *Optional* +
+	//
+	// Name of the policy in MinIO, the name PolicyBindings and users refer to. Defaults to the name of the MinIOPolicy resource, and can't be changed. +
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name,omitempty"`
+
+	// *Required* +
+	//
+	// The IAM policy document, with its `Version` and `Statement` fields. +
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Policy runtime.RawExtension `json:"policy"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=muser,singular=miniouser
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenant.name`
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.status.name`
+// +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.status.credentialsSecret`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:annotations=operator.min.io/version=v6.0.2
+
+// MinIOUser is a top-level type. A client is created for it
+type MinIOUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// *Required* +
+	//
+	// The root field for the MinIOUser object.
+	Spec MinIOUserSpec `json:"spec"`
+
+	// Status provides details of the state of the user in MinIO
+	// +optional
+	Status MinIOUserStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinIOUserList is a top-level list type.
+type MinIOUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MinIOUser `json:"items"`
+}
+
+// MinIOUserSpec (`spec`) defines a user of a MinIO Tenant. +
+type MinIOUserSpec struct {
+	// *Required* +
+	//
+	// The Tenant the user belongs to. The Tenant must be in the namespace of the MinIOUser. +
+	Tenant TenantReference `json:"tenant"`
+
+	// *Optional* +
+	//
+	// Name of the user in MinIO, its access key. Defaults to the name of the MinIOUser resource, and can't be changed. +
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name,omitempty"`
+
+	// *Optional* +
+	//
+	// Secret in the namespace of the MinIOUser holding the secret key of the user in its `CONSOLE_SECRET_KEY` field. When empty, the Operator generates the `<name>-credentials` Secret with the `CONSOLE_ACCESS_KEY` and `CONSOLE_SECRET_KEY` fields, and rotates its secret key when the `min.io/rotate-credentials` annotation of the MinIOUser changes. +
+	// +optional
+	CredentialsSecret *corev1.LocalObjectReference `json:"credentialsSecret,omitempty"`
+
+	// *Optional* +
+	//
+	// Policies attached to the user. +
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// *Optional* +
+	//
+	// Disables the user, its credentials are kept but rejected by MinIO. +
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// MinIOUserStatus is the status of a user synced to MinIO
+type MinIOUserStatus struct {
+	SyncStatus `json:",inline"`
+
+	// *Optional* +
+	//
+	// Secret holding the credentials of the user
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// *Optional* +
+	//
+	// Resource version of the credentials Secret last applied to MinIO
+	// +optional
+	CredentialsVersion string `json:"credentialsVersion,omitempty"`
+
+	// *Optional* +
+	//
+	// Value of the `min.io/rotate-credentials` annotation the credentials were last rotated for
+	// +optional
+	CredentialsRotation string `json:"credentialsRotation,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=mgroup,singular=miniogroup
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenant.name`
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.status.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:annotations=operator.min.io/version=v6.0.2
+
+// MinIOGroup is a top-level type. A client is created for it
+type MinIOGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// *Required* +
+	//
+	// The root field for the MinIOGroup object.
+	Spec MinIOGroupSpec `json:"spec"`
+
+	// Status provides details of the state of the group in MinIO
+	// +optional
+	Status SyncStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinIOGroupList is a top-level list type.
+type MinIOGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MinIOGroup `json:"items"`
+}
+
+// MinIOGroupSpec (`spec`) defines a group of a MinIO Tenant. +
+type MinIOGroupSpec struct {
+	// *Required* +
+	//
+	// The Tenant the group belongs to. The Tenant must be in the namespace of the MinIOGroup. +
+	Tenant TenantReference `json:"tenant"`
+
+	// *Optional* +
+	//
+	// Name of the group in MinIO. Defaults to the name of the MinIOGroup resource, and can't be changed. +
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name,omitempty"`
+
+	// *Optional* +
+	//
+	// Names of the MinIO users in the group. +
+	// +optional
+	Members []string `json:"members,omitempty"`
+
+	// *Optional* +
+	//
+	// Policies attached to the group, and so to its members. +
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// *Optional* +
+	//
+	// Disables the group, its members lose the policies of the group. +
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=maccesskey,singular=minioaccesskey
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenant.name`
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.spec.user`
+// +kubebuilder:printcolumn:name="Access Key",type=string,JSONPath=`.status.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:annotations=operator.min.io/version=v6.0.2
+
+// MinIOAccessKey is a top-level type. A client is created for it
+type MinIOAccessKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// *Required* +
+	//
+	// The root field for the MinIOAccessKey object.
+	Spec MinIOAccessKeySpec `json:"spec"`
+
+	// Status provides details of the state of the access key in MinIO
+	// +optional
+	Status MinIOAccessKeyStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinIOAccessKeyList is a top-level list type.
+type MinIOAccessKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MinIOAccessKey `json:"items"`
+}
+
+// MinIOAccessKeySpec (`spec`) defines an access key, also known as service account, of a MinIO user. +
+type MinIOAccessKeySpec struct {
+	// *Required* +
+	//
+	// The Tenant the access key belongs to. The Tenant must be in the namespace of the MinIOAccessKey. +
+	Tenant TenantReference `json:"tenant"`
+
+	// *Required* +
+	//
+	// Name of the MinIO user the access key belongs to. The access key has the policies of the user, or less. +
+	User string `json:"user"`
+
+	// *Optional* +
+	//
+	// IAM policy document restricting the access key to part of the policies of the user. The access key has all the policies of the user when empty. +
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Policy *runtime.RawExtension `json:"policy,omitempty"`
+
+	// *Optional* +
+	//
+	// Lifetime of each access key, for example `2160h`. The access keys don't expire when empty. +
+	// +optional
+	Expiry *metav1.Duration `json:"expiry,omitempty"`
+
+	// *Optional* +
+	//
+	// Secret the Operator writes the access key, secret key, endpoint and CA of the Tenant to. Defaults to `<name>-access-key`. +
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// *Optional* +
+	//
+	// Replaces the access key periodically. +
+	// +optional
+	Rotation *AccessKeyRotation `json:"rotation,omitempty"`
+}
+
+// AccessKeyRotation defines how often an access key is replaced
+type AccessKeyRotation struct {
+	// *Required* +
+	//
+	// Time between two rotations, for example `720h`. +
+	Period metav1.Duration `json:"period"`
+
+	// *Optional* +
+	//
+	// Time the replaced access key keeps working after a rotation, so the applications pick up the new one. It's deleted right away when empty. +
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// MinIOAccessKeyStatus is the status of an access key synced to MinIO
+type MinIOAccessKeyStatus struct {
+	SyncStatus `json:",inline"`
+
+	// *Optional* +
+	//
+	// Time the current access key was created
+	// +optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// *Optional* +
+	//
+	// Access keys replaced by a rotation, deleted from MinIO at the end of the grace period
+	// +optional
+	RetiredAccessKeys []RetiredAccessKey `json:"retiredAccessKeys,omitempty"`
+}
+
+// RetiredAccessKey is an access key replaced by a rotation
+type RetiredAccessKey struct {
+	// *Required* +
+	//
+	// The access key
+	Name string `json:"name"`
+
+	// *Required* +
+	//
+	// Time the access key is deleted from MinIO
+	DeletionTime metav1.Time `json:"deletionTime"`
+}
+
+// TenantReference is the reference to the Tenant of an IAM resource
+type TenantReference struct {
+	// *Required* +
+	//
+	// Name of the Tenant. +
+	Name string `json:"name"`
+}
+
+// SyncStatus is the status of an IAM resource synced to MinIO
+type SyncStatus struct {
+	// *Optional* +
+	//
+	// Generation of the resource last synced to MinIO
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// *Optional* +
+	//
+	// Name of the resource in MinIO
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// *Optional* +
+	//
+	// Conditions of the resource, `Ready` is true once MinIO matches the spec
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// *Optional* +
+	//
+	// Time the resource was last found changed in MinIO outside of the operator, and restored
+	// +optional
+	LastDriftTime *metav1.Time `json:"lastDriftTime,omitempty"`
+}
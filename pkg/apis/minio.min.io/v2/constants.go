@@ -66,6 +66,32 @@ const ZoneLabel = "v1.min.io/zone"
 // Revision is applied to all statefulsets
 const Revision = "min.io/revision"
 
+// MinIOServiceNameAnnotation keeps the name of the MinIO ClusterIP service of Tenants created when only one Tenant
+// was allowed per namespace
+const MinIOServiceNameAnnotation = "min.io/service-name"
+
+// TenantFinalizer lets the MinIO Operator clean up the resources of a Tenant that aren't removed along with it
+const TenantFinalizer = "min.io/tenant-cleanup"
+
+// AutoExpandProposalAnnotation holds the pool proposed by `spec.autoExpand` when it requires approval
+const AutoExpandProposalAnnotation = "min.io/auto-expand-proposal"
+
+// AutoExpandApproveAnnotation approves the proposed pool when it's set to its name
+const AutoExpandApproveAnnotation = "min.io/auto-expand-approve"
+
+// SuspendedReplicasAnnotation keeps the replicas a statefulset had before its tenant was suspended
+const SuspendedReplicasAnnotation = "min.io/suspended-replicas"
+
+// PoolTemplateHashAnnotation keeps the hash of the pod template the operator generated for a pool statefulset
+const PoolTemplateHashAnnotation = "min.io/pool-template-hash"
+
+// MaintenanceOverrideAnnotation allows disruptive operations outside the maintenance windows when it's set to `true`
+const MaintenanceOverrideAnnotation = "min.io/maintenance-override"
+
+// LegacyMinIOCIServiceName is the name of the MinIO ClusterIP service of Tenants created when only one Tenant was
+// allowed per namespace
+const LegacyMinIOCIServiceName = "minio"
+
 // MinIOPort specifies the default Tenant port number.
 const MinIOPort = 9000
 
@@ -96,6 +122,18 @@ const MinIOVolumeMountPath = "/export"
 // MinIOVolumeSubPath specifies the default sub path under mount path
 const MinIOVolumeSubPath = ""
 
+// DefaultDriveReplacementGracePeriod is the time a drive has to be offline before the operator replaces it
+const DefaultDriveReplacementGracePeriod = 30 * time.Minute
+
+// DefaultAutoExpandCooldown is the minimum time between two pools added by the operator to a tenant
+const DefaultAutoExpandCooldown = time.Hour
+
+// DefaultLostNodeRecoveryTimeout is the time a pod has to be stuck on a lost node before the operator releases its volumes
+const DefaultLostNodeRecoveryTimeout = 15 * time.Minute
+
+// DefaultMinisignPublicKey is the minisign public key MinIO releases are signed with
+const DefaultMinisignPublicKey = "RWTx5Zr1tiHQLwG9keckT0c45M3AGeHD6IvimQHpyRywVWGbP1aVSGav"
+
 // DefaultMinIOImage specifies the default MinIO Docker hub image
 const DefaultMinIOImage = "minio/minio:RELEASE.2024-08-03T04-33-23Z"
 
@@ -45,12 +45,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/set"
 )
 
 // Webhook API constants
@@ -427,6 +429,47 @@ func (t *Tenant) MinIOHosts() (hosts []string) {
 	return hosts
 }
 
+// DecommissioningPools returns the status of the pools removed from the spec that are still part of MinIO,
+// sorted by the index they had in the MinIO arguments
+func (t *Tenant) DecommissioningPools() []PoolStatus {
+	var pools []PoolStatus
+	for _, pool := range t.Status.Pools {
+		if !pool.State.Decommissioning() || pool.Decommission == nil || pool.Decommission.CmdLine == "" {
+			continue
+		}
+		pools = append(pools, *pool.DeepCopy())
+	}
+	sort.SliceStable(pools, func(i, j int) bool {
+		return pools[i].Decommission.Index < pools[j].Decommission.Index
+	})
+	return pools
+}
+
+// MinIOPoolStatefulSets returns the StatefulSets of the pools in the order MinIO indexes them, which is the order of
+// the MinIO arguments: the pools of the spec, with the pools being decommissioned kept at their previous index
+func (t *Tenant) MinIOPoolStatefulSets() []string {
+	ssNames := make([]string, 0, len(t.Spec.Pools))
+	for i, pool := range t.Spec.Pools {
+		if pool.Name == "" {
+			pool.Name = fmt.Sprintf("%s-%d", StatefulSetPrefix, i)
+		}
+		ssNames = append(ssNames, t.PoolStatefulsetName(&pool))
+	}
+	for _, pool := range t.DecommissioningPools() {
+		index := int(pool.Decommission.Index)
+		if index < 0 || index > len(ssNames) {
+			index = len(ssNames)
+		}
+		ssNames = append(ssNames[:index], append([]string{pool.SSName}, ssNames[index:]...)...)
+	}
+	return ssNames
+}
+
+// Decommissioning returns true if the pool was removed from the spec and is being, or was, drained by MinIO
+func (s PoolState) Decommissioning() bool {
+	return s == PoolDecommissioning || s == PoolDecommissionFailed || s == PoolDecommissioned
+}
+
 // TemplatedMinIOHosts returns the domain names in ellipses format created for current Tenant without the service part
 func (t *Tenant) TemplatedMinIOHosts(hostsTemplate string) (hosts []string) {
 	tmpl, err := template.New("hosts").Parse(hostsTemplate)
@@ -517,6 +560,23 @@ func (t *Tenant) HasKESEnabled() bool {
 	return t.Spec.KES != nil
 }
 
+// HasRebalanceEnabled checks if the data must be rebalanced across the pools after a new pool is added
+func (t *Tenant) HasRebalanceEnabled() bool {
+	return t.Spec.Rebalance != nil && t.Spec.Rebalance.Enabled
+}
+
+// RebalanceInProgress checks if a rebalance of the tenant is pending, running or failed and waiting to be retried
+func (t *Tenant) RebalanceInProgress() bool {
+	if t.Status.Rebalance == nil {
+		return false
+	}
+	switch t.Status.Rebalance.State {
+	case RebalancePending, RebalanceRunning, RebalanceFailed:
+		return true
+	}
+	return false
+}
+
 // HasPrometheusOperatorEnabled checks if Prometheus service monitor has been enabled
 func (t *Tenant) HasPrometheusOperatorEnabled() bool {
 	return t.Spec.PrometheusOperator
@@ -621,6 +681,107 @@ func (t *Tenant) MinIOHealthCheck(tr *http.Transport) bool {
 	return result.Healthy
 }
 
+// MinIOMaintenanceCheck returns true if the given MinIO pod can be taken down without the tenant losing write quorum
+func (t *Tenant) MinIOMaintenanceCheck(podName string, tr *http.Transport) bool {
+	if tr.TLSClientConfig != nil {
+		tr.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	clnt, err := madmin.NewAnonymousClient(fmt.Sprintf("%s:%d", t.MinIOHLPodHostname(podName), MinIOPort), t.TLS())
+	if err != nil {
+		return false
+	}
+	clnt.SetCustomTransport(tr)
+
+	result, err := clnt.Healthy(context.Background(), madmin.HealthOpts{Maintenance: true})
+	if err != nil {
+		return false
+	}
+
+	return result.Healthy
+}
+
+// MinIOImage returns the image the pools must run. It's the image in the spec, unless the upgrade to it was rolled back.
+// Once the image in the spec is verified it's pinned to the digest that was verified, so the tag can't be moved.
+func (t *Tenant) MinIOImage() string {
+	if t.Status.RolledBackImage != "" && t.Status.RolledBackImage == t.Spec.Image && t.Status.LastKnownGoodImage != "" {
+		return t.Status.LastKnownGoodImage
+	}
+	if v := t.Status.ImageVerification; v != nil && v.Verified && v.Digest != "" && v.Image == t.Spec.Image && !strings.Contains(t.Spec.Image, "@") {
+		return t.Spec.Image + "@" + v.Digest
+	}
+	return t.Spec.Image
+}
+
+// HasRollingUpgradeStrategy checks if the MinIO version is upgraded by restarting the pods one at a time
+func (t *Tenant) HasRollingUpgradeStrategy() bool {
+	return t.Spec.UpgradeStrategy == UpgradeStrategyRollingRestart
+}
+
+// HasImageVerification checks if the signatures of the MinIO image must be verified before upgrading the tenant
+func (t *Tenant) HasImageVerification() bool {
+	return t.Spec.ImageVerification != nil
+}
+
+// MinisignPublicKey returns the minisign public key MinIO uses to verify in-place updates
+func (t *Tenant) MinisignPublicKey() string {
+	if t.Spec.ImageVerification != nil && t.Spec.ImageVerification.MinisignPublicKey != "" {
+		return t.Spec.ImageVerification.MinisignPublicKey
+	}
+	return DefaultMinisignPublicKey
+}
+
+// DeletesPVCsWhenDeleted checks if the PersistentVolumeClaims of the tenant are deleted along with it
+func (t *Tenant) DeletesPVCsWhenDeleted() bool {
+	policy := t.Spec.PersistentVolumeClaimRetentionPolicy
+	return policy != nil && policy.WhenDeleted == DeletePersistentVolumeClaimRetentionPolicyType
+}
+
+// DeletesPVCsWhenPoolRemoved checks if the PersistentVolumeClaims of a pool are deleted once it is removed from the tenant
+func (t *Tenant) DeletesPVCsWhenPoolRemoved() bool {
+	policy := t.Spec.PersistentVolumeClaimRetentionPolicy
+	return policy != nil && policy.WhenPoolRemoved == DeletePersistentVolumeClaimRetentionPolicyType
+}
+
+// HasDriveReplacement checks if the failed drives of the tenant are replaced by the operator
+func (t *Tenant) HasDriveReplacement() bool {
+	return t.Spec.DriveReplacement != nil && t.Spec.DriveReplacement.Enabled
+}
+
+// DriveReplacementGracePeriod returns the time a drive has to be offline before it's replaced
+func (t *Tenant) DriveReplacementGracePeriod() time.Duration {
+	if t.Spec.DriveReplacement == nil || t.Spec.DriveReplacement.GracePeriod == nil || t.Spec.DriveReplacement.GracePeriod.Duration <= 0 {
+		return DefaultDriveReplacementGracePeriod
+	}
+	return t.Spec.DriveReplacement.GracePeriod.Duration
+}
+
+// AutoExpandCooldown returns the minimum time between two pools added by `spec.autoExpand`
+func (t *Tenant) AutoExpandCooldown() time.Duration {
+	if t.Spec.AutoExpand == nil || t.Spec.AutoExpand.Cooldown == nil || t.Spec.AutoExpand.Cooldown.Duration <= 0 {
+		return DefaultAutoExpandCooldown
+	}
+	return t.Spec.AutoExpand.Cooldown.Duration
+}
+
+// HasLostNodeRecovery checks if the operator recovers the pods of the tenant stuck on lost nodes
+func (t *Tenant) HasLostNodeRecovery() bool {
+	return t.Spec.LostNodeRecovery != nil && t.Spec.LostNodeRecovery.Enabled
+}
+
+// LostNodeRecoveryTimeout returns the time a pod has to be stuck on a lost node before its volumes are released
+func (t *Tenant) LostNodeRecoveryTimeout() time.Duration {
+	if t.Spec.LostNodeRecovery == nil || t.Spec.LostNodeRecovery.Timeout == nil || t.Spec.LostNodeRecovery.Timeout.Duration <= 0 {
+		return DefaultLostNodeRecoveryTimeout
+	}
+	return t.Spec.LostNodeRecovery.Timeout.Duration
+}
+
+// HasAutoExpand checks if pools are added to the tenant as its usage grows
+func (t *Tenant) HasAutoExpand() bool {
+	return t.Spec.AutoExpand != nil && t.Spec.AutoExpand.Enabled
+}
+
 // NewMinIOAdmin initializes a new madmin.Client for operator interaction
 func (t *Tenant) NewMinIOAdmin(minioSecret map[string][]byte, tr *http.Transport) (*madmin.AdminClient, error) {
 	return t.NewMinIOAdminForAddress("", minioSecret, tr)
@@ -782,34 +943,123 @@ func (z *Pool) Validate(zi int) error {
 		}
 	}
 
+	if len(z.VolumeClaimTemplates) > 0 {
+		return z.validateVolumeClaimTemplates(zi)
+	}
+
 	// Mandate a VolumeClaimTemplate
 	if z.VolumeClaimTemplate == nil {
 		return errors.New("a volume claim template must be specified")
 	}
 
+	return validateVolumeClaimTemplate(z.VolumeClaimTemplate)
+}
+
+// validateVolumeClaimTemplates validates the templates of a pool with several kinds of drives
+func (z *Pool) validateVolumeClaimTemplates(zi int) error {
+	if z.VolumeClaimTemplate != nil {
+		return fmt.Errorf("pool #%d cannot have both volumeClaimTemplate and volumeClaimTemplates", zi)
+	}
+
+	var volumes int32
+	names := set.NewStringSet()
+	for i := range z.VolumeClaimTemplates {
+		template := &z.VolumeClaimTemplates[i]
+		if template.Count <= 0 {
+			return fmt.Errorf("pool #%d volume claim template #%d must have a count greater than 0", zi, i)
+		}
+		volumes += template.Count
+		// the name of the template prefixes the name of its volumes
+		if template.Template.Name == "" {
+			return fmt.Errorf("pool #%d volume claim template #%d must have a name", zi, i)
+		}
+		if names.Contains(template.Template.Name) {
+			return fmt.Errorf("pool #%d volume claim template name '%s' is duplicated", zi, template.Template.Name)
+		}
+		names.Add(template.Template.Name)
+		if err := validateVolumeClaimTemplate(&template.Template); err != nil {
+			return err
+		}
+	}
+	if volumes != z.VolumesPerServer {
+		return fmt.Errorf("pool #%d volume claim templates generate %d volumes per server, volumesPerServer is %d", zi, volumes, z.VolumesPerServer)
+	}
+	// the index of the volume is appended to the name of the template, "a1" and "a" can generate the same name
+	volumeNames := set.NewStringSet()
+	for i := 0; i < int(volumes); i++ {
+		name := z.VolumeName(i)
+		if volumeNames.Contains(name) {
+			return fmt.Errorf("pool #%d volume claim templates generate the volume name '%s' more than once", zi, name)
+		}
+		volumeNames.Add(name)
+	}
+
+	// MinIO splits the drives of the pool in erasure sets of 2 to 16 drives
+	drives := z.Servers * z.VolumesPerServer
+	for setSize := int32(16); setSize >= 2; setSize-- {
+		if drives%setSize == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("pool #%d has %d drives, they can't be split in erasure sets of 2 to 16 drives", zi, drives)
+}
+
+// validateVolumeClaimTemplate validates a template of the PVCs of a pool
+func validateVolumeClaimTemplate(template *corev1.PersistentVolumeClaim) error {
 	// Mandate a resource request
-	if z.VolumeClaimTemplate.Spec.Resources.Requests == nil {
+	if template.Spec.Resources.Requests == nil {
 		return errors.New("volume claim template must specify resource request")
 	}
 
 	// Mandate a request of storage
-	if z.VolumeClaimTemplate.Spec.Resources.Requests.Storage() == nil {
+	if template.Spec.Resources.Requests.Storage() == nil {
 		return errors.New("volume claim template must specify resource storage request")
 	}
 
 	// Make sure the storage request is not 0
-	if z.VolumeClaimTemplate.Spec.Resources.Requests.Storage().Value() <= 0 {
+	if template.Spec.Resources.Requests.Storage().Value() <= 0 {
 		return errors.New("volume size must be greater than 0")
 	}
 
 	// Make sure access mode is provided
-	if len(z.VolumeClaimTemplate.Spec.AccessModes) == 0 {
+	if len(template.Spec.AccessModes) == 0 {
 		return errors.New("volume access mode must be specified")
 	}
 
 	return nil
 }
 
+// HasVolumeClaimTemplate returns true if the PVCs of the pool are generated by the operator
+func (z *Pool) HasVolumeClaimTemplate() bool {
+	return z.VolumeClaimTemplate != nil || len(z.VolumeClaimTemplates) > 0
+}
+
+// VolumeClaimTemplateFor returns the template of the volume with the given index on each server of the pool, or nil
+// if the pool has no template
+func (z *Pool) VolumeClaimTemplateFor(volume int) *corev1.PersistentVolumeClaim {
+	if len(z.VolumeClaimTemplates) == 0 {
+		return z.VolumeClaimTemplate
+	}
+	for i := range z.VolumeClaimTemplates {
+		if volume < int(z.VolumeClaimTemplates[i].Count) {
+			return &z.VolumeClaimTemplates[i].Template
+		}
+		volume -= int(z.VolumeClaimTemplates[i].Count)
+	}
+	return nil
+}
+
+// VolumeName returns the name of the volume with the given index on each server of the pool, prefixed by the name
+// of its template
+func (z *Pool) VolumeName(volume int) string {
+	// Default volume name, unless another one was provided
+	name := MinIOVolumeName
+	if template := z.VolumeClaimTemplateFor(volume); template != nil {
+		name = template.Name
+	}
+	return name + strconv.Itoa(volume)
+}
+
 // Validate returns an error if any configuration of the MinIO Tenant is invalid
 func (t *Tenant) Validate() error {
 	if t.Spec.Pools == nil {
@@ -820,6 +1070,12 @@ func (t *Tenant) Validate() error {
 		return errors.New("please set 'configuration' secret with credentials for Tenant")
 	}
 
+	if name, ok := t.Annotations[MinIOServiceNameAnnotation]; ok {
+		if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+			return fmt.Errorf("annotation %s must be a valid service name: %s", MinIOServiceNameAnnotation, strings.Join(errs, ", "))
+		}
+	}
+
 	if t.HasKESEnabled() {
 		switch {
 		case t.HasGCPCredentialSecretForKES() && !t.HasGCPWorkloadIdentityPoolForKES():
@@ -835,6 +1091,22 @@ func (t *Tenant) Validate() error {
 		if err := pool.Validate(zi); err != nil {
 			return err
 		}
+		if pool.ReplaceWith != nil {
+			if pool.ReplaceWith.Name == "" || pool.ReplaceWith.Name == pool.Name {
+				return fmt.Errorf("pool #%d replaceWith must have a name different from the pool", zi)
+			}
+			if err := pool.ReplaceWith.Validate(zi); err != nil {
+				return fmt.Errorf("pool #%d replaceWith: %w", zi, err)
+			}
+		}
+	}
+	for _, window := range t.Spec.MaintenanceWindows {
+		if err := window.Validate(); err != nil {
+			return fmt.Errorf("invalid maintenance window: %w", err)
+		}
+	}
+	if err := t.ValidateConfig(); err != nil {
+		return err
 	}
 	// make sure all the domains are valid
 	if err := t.ValidateDomains(); err != nil {
@@ -844,6 +1116,112 @@ func (t *Tenant) Validate() error {
 	return nil
 }
 
+// ValidateConfig returns an error if a subsystem, parameter or value of `spec.config` can't be applied to MinIO
+func (t *Tenant) ValidateConfig() error {
+	for subsys, kvs := range t.Spec.Config {
+		if subsys == "" || strings.ContainsAny(subsys, " =\"") {
+			return fmt.Errorf("invalid config subsystem '%s'", subsys)
+		}
+		for k, v := range kvs {
+			if k == "" || strings.ContainsAny(k, " =\"") {
+				return fmt.Errorf("invalid config parameter '%s' of subsystem '%s'", k, subsys)
+			}
+			if strings.ContainsAny(v, "\"\n") {
+				return fmt.Errorf("config parameter '%s' of subsystem '%s' can't contain quotes or newlines", k, subsys)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateUpdate returns an error if the transition from the old Tenant to the current one is not allowed.
+// The number of servers of an existing pool cannot change, and a pool can only be removed if every
+// remaining pool has a unique, non-empty name.
+func (t *Tenant) ValidateUpdate(old *Tenant) error {
+	if old == nil {
+		return nil
+	}
+
+	// a pool is being removed, remaining pools must be identifiable by name
+	if len(t.Spec.Pools) < len(old.Spec.Pools) {
+		poolNames := set.NewStringSet()
+		for pi, pool := range t.Spec.Pools {
+			if pool.Name == "" {
+				return fmt.Errorf("pool #%d must have a name before a pool can be removed", pi)
+			}
+			if poolNames.Contains(pool.Name) {
+				return fmt.Errorf("pool name '%s' is duplicated, pool names must be unique before a pool can be removed", pool.Name)
+			}
+			poolNames.Add(pool.Name)
+		}
+	}
+
+	oldPools := make(map[string]Pool, len(old.Spec.Pools))
+	for pi, pool := range old.Spec.Pools {
+		if pool.Name == "" {
+			pool.Name = fmt.Sprintf("%s-%d", StatefulSetPrefix, pi)
+		}
+		oldPools[pool.Name] = pool
+	}
+	for pi, pool := range t.Spec.Pools {
+		if pool.Name == "" {
+			pool.Name = fmt.Sprintf("%s-%d", StatefulSetPrefix, pi)
+		}
+		oldPool, ok := oldPools[pool.Name]
+		if !ok {
+			continue
+		}
+		if pool.Servers != oldPool.Servers {
+			return fmt.Errorf("pool '%s' servers cannot be changed from %d to %d", pool.Name, oldPool.Servers, pool.Servers)
+		}
+		if pool.VolumesPerServer != oldPool.VolumesPerServer {
+			return fmt.Errorf("pool '%s' volumesPerServer cannot be changed from %d to %d", pool.Name, oldPool.VolumesPerServer, pool.VolumesPerServer)
+		}
+		if err := validateVolumeClaimTemplatesUpdate(&pool, &oldPool); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateServiceNames returns an error if the tenant would create a service with the same name as a service of
+// another tenant in the namespace
+func (t *Tenant) ValidateServiceNames(tenants []Tenant) error {
+	names := set.CreateStringSet(t.ServiceNames()...)
+	for i := range tenants {
+		if tenants[i].Name == t.Name {
+			continue
+		}
+		for _, name := range tenants[i].ServiceNames() {
+			if names.Contains(name) {
+				return fmt.Errorf("service '%s' of the tenant is already used by tenant '%s'", name, tenants[i].Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateVolumeClaimTemplatesUpdate rejects changes to the volumes of an existing pool, the volume claim templates of
+// its statefulset can't be updated and its pods would mount volumes that don't exist
+func validateVolumeClaimTemplatesUpdate(pool, oldPool *Pool) error {
+	if (pool.VolumeClaimTemplate == nil) != (oldPool.VolumeClaimTemplate == nil) || len(pool.VolumeClaimTemplates) != len(oldPool.VolumeClaimTemplates) {
+		return fmt.Errorf("pool '%s' cannot switch between volumeClaimTemplate and volumeClaimTemplates or change the number of templates", pool.Name)
+	}
+	for i := range pool.VolumeClaimTemplates {
+		template, oldTemplate := pool.VolumeClaimTemplates[i], oldPool.VolumeClaimTemplates[i]
+		if template.Template.Name != oldTemplate.Template.Name || template.Count != oldTemplate.Count {
+			return fmt.Errorf("pool '%s' volume claim template #%d cannot be changed from %d '%s' volumes to %d '%s' volumes", pool.Name, i, oldTemplate.Count, oldTemplate.Template.Name, template.Count, template.Template.Name)
+		}
+	}
+	for i := 0; i < int(pool.VolumesPerServer); i++ {
+		if name, oldName := pool.VolumeName(i), oldPool.VolumeName(i); name != oldName {
+			return fmt.Errorf("pool '%s' volume %d cannot be renamed from '%s' to '%s'", pool.Name, i, oldName, name)
+		}
+	}
+	return nil
+}
+
 // OwnerRef returns the OwnerReference to be added to all resources created by Tenant
 func (t *Tenant) OwnerRef() []metav1.OwnerReference {
 	return []metav1.OwnerReference{
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -523,3 +524,418 @@ func TestTenant_ValidateDomains(t1 *testing.T) {
 		})
 	}
 }
+
+func TestTenant_ValidateUpdate(t *testing.T) {
+	template := func(name string, count int32) PoolVolumeClaimTemplate {
+		return PoolVolumeClaimTemplate{Count: count, Template: corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name}}}
+	}
+	data := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data"}}
+	tests := []struct {
+		name     string
+		oldPools []Pool
+		newPools []Pool
+		wantErr  bool
+	}{
+		{
+			name:     "No changes",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			wantErr:  false,
+		},
+		{
+			name:     "Adding a pool",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}, {Name: "pool-1", Servers: 8, VolumesPerServer: 2}},
+			wantErr:  false,
+		},
+		{
+			name:     "Changing servers",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Name: "pool-0", Servers: 8, VolumesPerServer: 4}},
+			wantErr:  true,
+		},
+		{
+			name:     "Changing servers of an unnamed pool",
+			oldPools: []Pool{{Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Servers: 6, VolumesPerServer: 4}},
+			wantErr:  true,
+		},
+		{
+			name:     "Changing volumes per server",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 2}},
+			wantErr:  true,
+		},
+		{
+			name:     "Unchanged volume claim templates",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("nvme", 2), template("hdd", 2)}}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("nvme", 2), template("hdd", 2)}}},
+			wantErr:  false,
+		},
+		{
+			name:     "Switching to volumeClaimTemplates",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplate: data}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("data", 4)}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Renaming the volumeClaimTemplate",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplate: data}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplate: &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "drive"}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Reordering volume claim templates",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("nvme", 2), template("hdd", 2)}}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("hdd", 2), template("nvme", 2)}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Changing the count of volume claim templates",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("nvme", 2), template("hdd", 2)}}},
+			newPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{template("nvme", 1), template("hdd", 3)}}},
+			wantErr:  true,
+		},
+		{
+			name:     "Removing a pool",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}, {Name: "pool-1", Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Name: "pool-1", Servers: 4, VolumesPerServer: 4}},
+			wantErr:  false,
+		},
+		{
+			name:     "Removing a pool with unnamed pools",
+			oldPools: []Pool{{Servers: 4, VolumesPerServer: 4}, {Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Servers: 4, VolumesPerServer: 4}},
+			wantErr:  true,
+		},
+		{
+			name:     "Removing a pool with duplicated names",
+			oldPools: []Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}, {Name: "pool-1", Servers: 4, VolumesPerServer: 4}, {Name: "pool-2", Servers: 4, VolumesPerServer: 4}},
+			newPools: []Pool{{Name: "pool-1", Servers: 4, VolumesPerServer: 4}, {Name: "pool-1", Servers: 4, VolumesPerServer: 4}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldTenant := &Tenant{Spec: TenantSpec{Pools: tt.oldPools}}
+			newTenant := &Tenant{Spec: TenantSpec{Pools: tt.newPools}}
+			err := newTenant.ValidateUpdate(oldTenant)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTenant_MinIOImage(t *testing.T) {
+	tests := []struct {
+		name   string
+		status TenantStatus
+		want   string
+	}{
+		{
+			name: "No rollback",
+			status: TenantStatus{
+				LastKnownGoodImage: "minio/minio:RELEASE.2024-01-01T00-00-00Z",
+			},
+			want: "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+		},
+		{
+			name: "Image in the spec was rolled back",
+			status: TenantStatus{
+				LastKnownGoodImage: "minio/minio:RELEASE.2024-01-01T00-00-00Z",
+				RolledBackImage:    "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+			},
+			want: "minio/minio:RELEASE.2024-01-01T00-00-00Z",
+		},
+		{
+			name: "Image changed after a rollback",
+			status: TenantStatus{
+				LastKnownGoodImage: "minio/minio:RELEASE.2024-01-01T00-00-00Z",
+				RolledBackImage:    "minio/minio:RELEASE.2023-12-01T00-00-00Z",
+			},
+			want: "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+		},
+		{
+			name: "Image in the spec was verified",
+			status: TenantStatus{
+				ImageVerification: &ImageVerificationStatus{
+					Image:    "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+					Verified: true,
+					Digest:   "sha256:0123",
+				},
+			},
+			want: "minio/minio:RELEASE.2024-02-01T00-00-00Z@sha256:0123",
+		},
+		{
+			name: "Image in the spec failed verification",
+			status: TenantStatus{
+				ImageVerification: &ImageVerificationStatus{
+					Image:  "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+					Digest: "sha256:0123",
+				},
+			},
+			want: "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+		},
+		{
+			name: "Another image was verified",
+			status: TenantStatus{
+				ImageVerification: &ImageVerificationStatus{
+					Image:    "minio/minio:RELEASE.2024-01-01T00-00-00Z",
+					Verified: true,
+					Digest:   "sha256:0123",
+				},
+			},
+			want: "minio/minio:RELEASE.2024-02-01T00-00-00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &Tenant{
+				Spec:   TenantSpec{Image: "minio/minio:RELEASE.2024-02-01T00-00-00Z"},
+				Status: tt.status,
+			}
+			if got := tenant.MinIOImage(); got != tt.want {
+				t.Errorf("MinIOImage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTenant_MinIOCIServiceName(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        string
+	}{
+		{
+			name: "Service named after the tenant",
+			want: "tenant-a",
+		},
+		{
+			name:        "Tenant created with a single tenant per namespace",
+			annotations: map[string]string{MinIOServiceNameAnnotation: LegacyMinIOCIServiceName},
+			want:        "minio",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &Tenant{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "tenant-a",
+					Namespace:   "team",
+					Annotations: tt.annotations,
+				},
+			}
+			if got := tenant.MinIOCIServiceName(); got != tt.want {
+				t.Errorf("MinIOCIServiceName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTenant_ValidateServiceNames(t *testing.T) {
+	newTenant := func(name, serviceName string) Tenant {
+		tenant := Tenant{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team"}}
+		if serviceName != "" {
+			tenant.Annotations = map[string]string{MinIOServiceNameAnnotation: serviceName}
+		}
+		return tenant
+	}
+	tests := []struct {
+		name    string
+		tenant  Tenant
+		tenants []Tenant
+		wantErr bool
+	}{
+		{
+			name:    "Tenants with distinct names",
+			tenant:  newTenant("b", ""),
+			tenants: []Tenant{newTenant("a", ""), newTenant("b", "")},
+		},
+		{
+			name:    "Named like the console service of another tenant",
+			tenant:  newTenant("a-console", ""),
+			tenants: []Tenant{newTenant("a", "")},
+			wantErr: true,
+		},
+		{
+			name:    "Named like the service of a legacy tenant",
+			tenant:  newTenant(LegacyMinIOCIServiceName, ""),
+			tenants: []Tenant{newTenant("a", LegacyMinIOCIServiceName)},
+			wantErr: true,
+		},
+		{
+			name:    "Annotation set to the headless service of another tenant",
+			tenant:  newTenant("b", "a-hl"),
+			tenants: []Tenant{newTenant("a", "")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tenant.ValidateServiceNames(tt.tenants); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateServiceNames() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTenant_DeletesPVCs(t *testing.T) {
+	tests := []struct {
+		name                string
+		policy              *TenantPersistentVolumeClaimRetentionPolicy
+		wantWhenDeleted     bool
+		wantWhenPoolRemoved bool
+	}{
+		{
+			name: "No policy",
+		},
+		{
+			name:   "Retain",
+			policy: &TenantPersistentVolumeClaimRetentionPolicy{WhenDeleted: RetainPersistentVolumeClaimRetentionPolicyType, WhenPoolRemoved: RetainPersistentVolumeClaimRetentionPolicyType},
+		},
+		{
+			name:            "Delete when the tenant is deleted",
+			policy:          &TenantPersistentVolumeClaimRetentionPolicy{WhenDeleted: DeletePersistentVolumeClaimRetentionPolicyType},
+			wantWhenDeleted: true,
+		},
+		{
+			name:                "Delete when a pool is removed",
+			policy:              &TenantPersistentVolumeClaimRetentionPolicy{WhenPoolRemoved: DeletePersistentVolumeClaimRetentionPolicyType},
+			wantWhenPoolRemoved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &Tenant{Spec: TenantSpec{PersistentVolumeClaimRetentionPolicy: tt.policy}}
+			if got := tenant.DeletesPVCsWhenDeleted(); got != tt.wantWhenDeleted {
+				t.Errorf("DeletesPVCsWhenDeleted() = %v, want %v", got, tt.wantWhenDeleted)
+			}
+			if got := tenant.DeletesPVCsWhenPoolRemoved(); got != tt.wantWhenPoolRemoved {
+				t.Errorf("DeletesPVCsWhenPoolRemoved() = %v, want %v", got, tt.wantWhenPoolRemoved)
+			}
+		})
+	}
+}
+
+func TestPool_VolumeClaimTemplates(t *testing.T) {
+	template := func(name, size string) PoolVolumeClaimTemplate {
+		return PoolVolumeClaimTemplate{
+			Template: corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+					},
+				},
+			},
+		}
+	}
+	nvme := template("nvme", "1Ti")
+	nvme.Count = 2
+	hdd := template("hdd", "10Ti")
+	hdd.Count = 4
+	largeHDD := template("hdd", "10Ti")
+	largeHDD.Count = 15
+	// "a1" volume 0 and "a" volume 10 are both named "a10"
+	a1 := template("a1", "1Ti")
+	a1.Count = 1
+	a := template("a", "1Ti")
+	a.Count = 11
+
+	tests := []struct {
+		name    string
+		pool    Pool
+		wantErr bool
+	}{
+		{
+			name: "NVMe and HDD drives",
+			pool: Pool{Servers: 4, VolumesPerServer: 6, VolumeClaimTemplates: []PoolVolumeClaimTemplate{nvme, hdd}},
+		},
+		{
+			name:    "Counts don't match volumesPerServer",
+			pool:    Pool{Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{nvme, hdd}},
+			wantErr: true,
+		},
+		{
+			name:    "Drives can't be split in erasure sets",
+			pool:    Pool{Servers: 17, VolumesPerServer: 17, VolumeClaimTemplates: []PoolVolumeClaimTemplate{nvme, largeHDD}},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicated template names",
+			pool:    Pool{Servers: 4, VolumesPerServer: 4, VolumeClaimTemplates: []PoolVolumeClaimTemplate{nvme, nvme}},
+			wantErr: true,
+		},
+		{
+			name:    "Generated volume names collide",
+			pool:    Pool{Servers: 4, VolumesPerServer: 12, VolumeClaimTemplates: []PoolVolumeClaimTemplate{a1, a}},
+			wantErr: true,
+		},
+		{
+			name: "Combined with volumeClaimTemplate",
+			pool: Pool{Servers: 4, VolumesPerServer: 6, VolumeClaimTemplates: []PoolVolumeClaimTemplate{nvme, hdd},
+				VolumeClaimTemplate: &hdd.Template},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.pool.Validate(0); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	pool := Pool{Servers: 4, VolumesPerServer: 6, VolumeClaimTemplates: []PoolVolumeClaimTemplate{nvme, hdd}}
+	for volume, want := range []string{"nvme", "nvme", "hdd", "hdd", "hdd", "hdd"} {
+		assert.Equal(t, want, pool.VolumeClaimTemplateFor(volume).Name)
+	}
+	assert.Nil(t, pool.VolumeClaimTemplateFor(6))
+	assert.Equal(t, "nvme1", pool.VolumeName(1))
+	assert.Equal(t, "hdd2", pool.VolumeName(2))
+}
+
+func TestTenant_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "Subsystem and target",
+			config: map[string]map[string]string{"api": {"requests_max": "1000"}, "notify_webhook:primary": {"enable": "on", "endpoint": "http://webhook:8080"}},
+		},
+		{
+			name:   "Value with spaces",
+			config: map[string]map[string]string{"subnet": {"proxy": "http://proxy:3128 "}},
+		},
+		{
+			name:    "Empty subsystem",
+			config:  map[string]map[string]string{"": {"requests_max": "1000"}},
+			wantErr: true,
+		},
+		{
+			name:    "Parameter with spaces",
+			config:  map[string]map[string]string{"api": {"requests max": "1000"}},
+			wantErr: true,
+		},
+		{
+			name:    "Value with quotes",
+			config:  map[string]map[string]string{"api": {"requests_max": `1000"`}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := Tenant{Spec: TenantSpec{Config: tt.config}}
+			if err := tenant.ValidateConfig(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
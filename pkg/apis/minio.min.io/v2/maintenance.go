@@ -0,0 +1,216 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// the operator image doesn't ship the time zone database
+	_ "time/tzdata"
+)
+
+// cronSchedule is a parsed cron schedule, each field is a bitset of the values it matches
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// a day matches both day fields if one of them is `*`, else it matches either of them
+	anyDom, anyDow bool
+}
+
+// parseCronSchedule parses a schedule with the minute, hour, day of month, month and day of week fields. Each field
+// is `*` or a list of values, ranges (`1-5`) and steps (`*/15`, `0-30/10`). Sunday is either 0 or 7.
+func parseCronSchedule(schedule string) (*cronSchedule, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule '%s' must have 5 fields, got %d", schedule, len(fields))
+	}
+	var s cronSchedule
+	var err error
+	if s.minute, _, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if s.dom, s.anyDom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if s.dow, s.anyDow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return &s, nil
+}
+
+// parseCronField returns the values matched by a field, and whether the field is `*`
+func parseCronField(field string, min, max int) (uint64, bool, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, false, fmt.Errorf("invalid step in '%s'", part)
+			}
+		}
+		low, high := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			var err1, err2 error
+			low, err1 = strconv.Atoi(bounds[0])
+			high, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return 0, false, fmt.Errorf("invalid range '%s'", rng)
+			}
+		default:
+			value, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, false, fmt.Errorf("invalid value '%s'", rng)
+			}
+			low = value
+			if step == 1 {
+				high = value
+			}
+		}
+		if low < min || high > max || low > high {
+			return 0, false, fmt.Errorf("'%s' is out of the range %d-%d", part, min, max)
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, field == "*", nil
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.anyDom || s.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// next returns the first time after t matched by the schedule, in the location of t. Returns false if the schedule
+// matches no time in the next five years, such as `0 0 31 2 *`.
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	loc := t.Location()
+	for t.Before(limit) {
+		previous := t
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+		// daylight saving changes can move a local time backwards
+		if !t.After(previous) {
+			t = previous.Add(time.Minute)
+		}
+	}
+	return time.Time{}, false
+}
+
+func (w MaintenanceWindow) location() (*time.Location, error) {
+	if w.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(w.TimeZone)
+}
+
+// Validate returns an error if the schedule, duration or time zone of the window are invalid
+func (w MaintenanceWindow) Validate() error {
+	if _, err := parseCronSchedule(w.Schedule); err != nil {
+		return err
+	}
+	if w.Duration.Duration <= 0 {
+		return fmt.Errorf("duration of the window '%s' must be positive", w.Schedule)
+	}
+	if _, err := w.location(); err != nil {
+		return fmt.Errorf("invalid timeZone '%s': %w", w.TimeZone, err)
+	}
+	return nil
+}
+
+// Open returns true if the window is open at the given time
+func (w MaintenanceWindow) Open(now time.Time) bool {
+	start, ok := w.startAfter(now.Add(-w.Duration.Duration))
+	return ok && !start.After(now)
+}
+
+// NextStart returns the time the window opens next after the given time
+func (w MaintenanceWindow) NextStart(now time.Time) (time.Time, bool) {
+	return w.startAfter(now)
+}
+
+func (w MaintenanceWindow) startAfter(t time.Time) (time.Time, bool) {
+	schedule, err := parseCronSchedule(w.Schedule)
+	if err != nil {
+		return time.Time{}, false
+	}
+	loc, err := w.location()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return schedule.next(t.In(loc))
+}
+
+// HasMaintenanceWindows returns true if disruptive operations are restricted to maintenance windows
+func (t *Tenant) HasMaintenanceWindows() bool {
+	return len(t.Spec.MaintenanceWindows) > 0
+}
+
+// MaintenanceAllowed returns true if disruptive operations can be performed at the given time: no window is set, a
+// window is open or the override annotation is set
+func (t *Tenant) MaintenanceAllowed(now time.Time) bool {
+	if !t.HasMaintenanceWindows() || t.Annotations[MaintenanceOverrideAnnotation] == "true" {
+		return true
+	}
+	for _, window := range t.Spec.MaintenanceWindows {
+		if window.Open(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextMaintenanceWindow returns the time the next maintenance window opens after the given time
+func (t *Tenant) NextMaintenanceWindow(now time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, window := range t.Spec.MaintenanceWindows {
+		if start, ok := window.NextStart(now); ok && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return next, !next.IsZero()
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package v2
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceWindow_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:   "Valid window",
+			window: MaintenanceWindow{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Berlin"},
+		},
+		{
+			name:   "Lists, ranges and steps",
+			window: MaintenanceWindow{Schedule: "*/15 1-5 1,15 */2 1-5", Duration: metav1.Duration{Duration: time.Hour}},
+		},
+		{
+			name:    "Missing field",
+			window:  MaintenanceWindow{Schedule: "0 2 * *", Duration: metav1.Duration{Duration: time.Hour}},
+			wantErr: true,
+		},
+		{
+			name:    "Value out of range",
+			window:  MaintenanceWindow{Schedule: "0 24 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			wantErr: true,
+		},
+		{
+			name:    "No duration",
+			window:  MaintenanceWindow{Schedule: "0 2 * * *"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown time zone",
+			window:  MaintenanceWindow{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.window.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindow_Open(t *testing.T) {
+	// every Saturday from 02:00 to 06:00 in Berlin, which is UTC+2 in June
+	window := MaintenanceWindow{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Berlin"}
+	tests := []struct {
+		name     string
+		now      time.Time
+		wantOpen bool
+		wantNext time.Time
+	}{
+		{
+			name:     "Before the window",
+			now:      time.Date(2024, 6, 7, 23, 0, 0, 0, time.UTC),
+			wantNext: time.Date(2024, 6, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Window open",
+			now:      time.Date(2024, 6, 8, 1, 30, 0, 0, time.UTC),
+			wantOpen: true,
+			wantNext: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Window closed",
+			now:      time.Date(2024, 6, 8, 4, 0, 0, 0, time.UTC),
+			wantNext: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if open := window.Open(tt.now); open != tt.wantOpen {
+				t.Errorf("Open() = %v, want %v", open, tt.wantOpen)
+			}
+			next, ok := window.NextStart(tt.now)
+			if !ok || !next.Equal(tt.wantNext) {
+				t.Errorf("NextStart() = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestTenant_MaintenanceAllowed(t *testing.T) {
+	now := time.Date(2024, 6, 5, 12, 0, 0, 0, time.UTC)
+	window := MaintenanceWindow{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}}
+	tenant := &Tenant{}
+	if !tenant.MaintenanceAllowed(now) {
+		t.Errorf("MaintenanceAllowed() = false without maintenance windows")
+	}
+	tenant.Spec.MaintenanceWindows = []MaintenanceWindow{window}
+	if tenant.MaintenanceAllowed(now) {
+		t.Errorf("MaintenanceAllowed() = true outside the maintenance windows")
+	}
+	tenant.Annotations = map[string]string{MaintenanceOverrideAnnotation: "true"}
+	if !tenant.MaintenanceAllowed(now) {
+		t.Errorf("MaintenanceAllowed() = false with the override annotation")
+	}
+}
@@ -57,9 +57,20 @@ func (t *Tenant) MinIOHLServiceName() string {
 // MinIOCIServiceName returns the name of Cluster IP service that is created to communicate
 // with current MinIO StatefulSet pods
 func (t *Tenant) MinIOCIServiceName() string {
-	// DO NOT CHANGE, this should be constant
-	// This is possible because each namespace has only one Tenant
-	return "minio"
+	// Tenants created when only one Tenant was allowed per namespace keep their service name
+	if name := t.Annotations[MinIOServiceNameAnnotation]; name != "" {
+		return name
+	}
+	return t.Name
+}
+
+// ServiceNames returns the names of the services created for the tenant, they must be unique in the namespace
+func (t *Tenant) ServiceNames() []string {
+	names := []string{t.MinIOCIServiceName(), t.MinIOHLServiceName(), t.ConsoleCIServiceName()}
+	if t.HasKESEnabled() {
+		names = append(names, t.KESHLServiceName())
+	}
+	return names
 }
 
 // MinIOBucketBaseDomain returns the base domain name for buckets
@@ -17,6 +17,7 @@ package v2
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -271,6 +272,70 @@ type TenantSpec struct {
 	KES *KESConfig `json:"kes,omitempty"`
 	// *Optional* +
 	//
+	// Directs the MinIO Operator to rebalance the data across all the pools of the Tenant after a new pool is initialized. +
+	//
+	// Disabling the rebalance while it runs stops it. +
+	//+optional
+	Rebalance *RebalanceConfig `json:"rebalance,omitempty"`
+	// *Optional* +
+	//
+	// The strategy used to upgrade the MinIO version of the Tenant. Defaults to `InPlace`. +
+	//
+	// * `InPlace` updates the MinIO binary of the running pods using the MinIO Operator upgrade webhook, then updates the pool StatefulSets. +
+	//
+	// * `RollingRestart` updates the pool StatefulSets one pod at a time, one pool after the other. The MinIO Operator waits for the Tenant to be healthy and to keep write quorum before restarting each pod. +
+	// +kubebuilder:validation:Enum=InPlace;RollingRestart
+	// +optional
+	UpgradeStrategy UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	// *Optional* +
+	//
+	// Time the Tenant has to return to a `green` health after an upgrade of its MinIO image. If the deadline is exceeded, the MinIO Operator reverts the pools to the last known good image, emits an event and sets the `UpgradeRolledBack` condition. +
+	//
+	// The pools keep running the last known good image until `image` is changed again. If not set, failed upgrades are not rolled back. +
+	// +optional
+	UpgradeRollbackDeadline *metav1.Duration `json:"upgradeRollbackDeadline,omitempty"`
+	// *Optional* +
+	//
+	// Signatures the MinIO image must carry before the MinIO Operator upgrades the Tenant to it. If the verification fails the upgrade is refused. +
+	// +optional
+	ImageVerification *ImageVerification `json:"imageVerification,omitempty"`
+	// *Optional* +
+	//
+	// Controls what happens to the PersistentVolumeClaims of the Tenant when it is deleted or one of its pools is removed. The PersistentVolumeClaims are retained by default. +
+	// +optional
+	PersistentVolumeClaimRetentionPolicy *TenantPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+	// *Optional* +
+	//
+	// Replaces the PersistentVolumeClaims of drives MinIO reports as failed, so MinIO heals fresh drives in their place. Disabled by default. +
+	// +optional
+	DriveReplacement *DriveReplacement `json:"driveReplacement,omitempty"`
+	// *Optional* +
+	//
+	// Releases the PersistentVolumeClaims of pods that can't be scheduled because their local PersistentVolumes are on a node that is gone or `NotReady`, so the pods reschedule on another node and MinIO heals their drives. Disabled by default. +
+	// +optional
+	LostNodeRecovery *LostNodeRecovery `json:"lostNodeRecovery,omitempty"`
+	// *Optional* +
+	//
+	// Stops the Operator from reconciling the Tenant, for example while doing manual maintenance on its resources. The StatefulSets, PodDisruptionBudgets and MinIO version of the Tenant are left as they are, and no drive or pod is replaced, until `paused` is set back to `false`. The health of the Tenant is still reported. +
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// *Optional* +
+	//
+	// Scales the pools and KES of the Tenant down to zero pods and removes its PodDisruptionBudgets. The PVCs, secrets and certificates are kept, and the pods are created again once `suspend` is set back to `false`. +
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+	// *Optional* +
+	//
+	// Recurring windows in which the Operator performs disruptive operations: restarts of the pools, MinIO upgrades, PVC expansions, updates of the StatefulSet templates and restarts required by `config`. Outside the windows these operations are queued in `status.pendingMaintenance` until a window opens, unless the `min.io/maintenance-override` annotation is set to `true`. Disruptive operations run as soon as they're needed when no window is set. +
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// *Optional* +
+	//
+	// Adds a new pool to the Tenant when its usage crosses a threshold. +
+	// +optional
+	AutoExpand *AutoExpand `json:"autoExpand,omitempty"`
+	// *Optional* +
+	//
 	// Directs the MinIO Operator to use prometheus operator. +
 	//
 	// Tenant scrape configuration will be added to prometheus managed by the prometheus-operator.
@@ -329,6 +394,8 @@ type TenantSpec struct {
 	// * `CONSOLE_SECRET_KEY` - The "Password" for the MinIO user +
 	//
 	// The Operator creates each user with the `consoleAdmin` policy by default. You can change the assigned policy after the Tenant starts. +
+	//
+	// Deprecated: the users are only created once, use MinIOUser resources to manage the users of the Tenant. +
 	// +optional
 	// +deprecated
 	Users []corev1.LocalObjectReference `json:"users,omitempty"`
@@ -351,6 +418,13 @@ type TenantSpec struct {
 	Configuration *corev1.LocalObjectReference `json:"configuration,omitempty"`
 	// *Optional* +
 	//
+	// MinIO server configuration, keyed by config subsystem and then by config parameter, for example `api: {requests_max: "1000"}`. Targets of a subsystem use the `subsystem:target` key, for example `notify_webhook:primary`. +
+	//
+	// The Operator applies the configuration to the running Tenant, as `mc admin config set` does, and restarts MinIO only when the changed parameters require it. Parameters changed outside of the Tenant are restored and reported in `status.config`. Removing a parameter leaves its current value in MinIO. The environment variables of `configuration` take precedence over this configuration, and secrets belong in `configuration`, not here. +
+	// +optional
+	Config map[string]map[string]string `json:"config,omitempty"`
+	// *Optional* +
+	//
 	// Add custom initContainers to StatefulSet
 	// +optional
 	InitContainers []corev1.Container `json:"initContainers,omitempty"`
@@ -443,6 +517,13 @@ const (
 	PoolCreated PoolState = "PoolCreated"
 	// PoolInitialized indicates if a pool has been observed to be online
 	PoolInitialized PoolState = "PoolInitialized"
+	// PoolDecommissioning indicates a pool removed from the spec is draining its data to the remaining pools
+	PoolDecommissioning PoolState = "PoolDecommissioning"
+	// PoolDecommissionFailed indicates MinIO stopped draining a pool removed from the spec, the decommission is retried
+	// with a backoff
+	PoolDecommissionFailed PoolState = "PoolDecommissionFailed"
+	// PoolDecommissioned indicates a pool finished draining its data and can be removed
+	PoolDecommissioned PoolState = "PoolDecommissioned"
 )
 
 // PoolStatus keeps track of all the pools and their current state
@@ -454,6 +535,358 @@ type PoolStatus struct {
 	// Security Context
 	// +optional
 	LegacySecurityContext bool `json:"legacySecurityContext"`
+	// *Optional* +
+	//
+	// Number of pods of the pool with a Ready condition
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// *Optional* +
+	//
+	// Number of drives online in the pool
+	// +optional
+	DrivesOnline int32 `json:"drivesOnline,omitempty"`
+	// *Optional* +
+	//
+	// Number of drives offline in the pool
+	// +optional
+	DrivesOffline int32 `json:"drivesOffline,omitempty"`
+	// *Optional* +
+	//
+	// Number of drives healing in the pool
+	// +optional
+	DrivesHealing int32 `json:"drivesHealing,omitempty"`
+	// *Optional* +
+	//
+	// Erasure code parity used by the standard storage class of the pool
+	// +optional
+	Parity int32 `json:"parity,omitempty"`
+	// *Optional* +
+	//
+	// Usage and capacity of the pool, computed the same way as the tenant usage
+	// +optional
+	Usage PoolUsage `json:"usage,omitempty"`
+	// *Optional* +
+	//
+	// Progress of the decommission of a pool removed from the spec
+	// +optional
+	Decommission *PoolDecommissionStatus `json:"decommission,omitempty"`
+	// *Optional* +
+	//
+	// PersistentVolumeClaims of the pool being expanded to the size requested in the volumeClaimTemplate
+	// +optional
+	VolumeResizes []PVCResizeStatus `json:"volumeResizes,omitempty"`
+}
+
+// PVCResizeState is the progress of the expansion of a PersistentVolumeClaim
+type PVCResizeState string
+
+const (
+	// PVCResizePending the new size was requested, and the resize didn't start yet
+	PVCResizePending PVCResizeState = "Pending"
+	// PVCResizeResizing the volume is being expanded by the storage provider
+	PVCResizeResizing PVCResizeState = "Resizing"
+	// PVCResizeFileSystemResizePending the volume was expanded, the file system is expanded once the pod restarts or the node resizes it
+	PVCResizeFileSystemResizePending PVCResizeState = "FileSystemResizePending"
+	// PVCResizeFailed the storage provider failed to expand the volume
+	PVCResizeFailed PVCResizeState = "Failed"
+)
+
+// PVCResizeStatus tracks the expansion of a PersistentVolumeClaim of a pool
+type PVCResizeStatus struct {
+	// Name of the PersistentVolumeClaim
+	Name string `json:"name"`
+	// Requested is the size requested for the PersistentVolumeClaim
+	Requested resource.Quantity `json:"requested"`
+	// Capacity is the current size of the volume
+	// +optional
+	Capacity resource.Quantity `json:"capacity,omitempty"`
+	// State of the resize
+	State PVCResizeState `json:"state"`
+	// Message is the reason of a failed resize
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DriveReplacementState is the step of the replacement of a failed drive
+type DriveReplacementState string
+
+const (
+	// DriveReplacementOffline the drive is offline, it's replaced once the grace period expires
+	DriveReplacementOffline DriveReplacementState = "Offline"
+	// DriveReplacementReplacing the PersistentVolumeClaim was deleted and the pod restarted, waiting for the new drive to be online
+	DriveReplacementReplacing DriveReplacementState = "Replacing"
+	// DriveReplacementHealing MinIO is healing the new drive
+	DriveReplacementHealing DriveReplacementState = "Healing"
+)
+
+// DriveReplacementStatus tracks the replacement of a failed drive
+type DriveReplacementStatus struct {
+	// Endpoint of the drive reported by MinIO
+	Endpoint string `json:"endpoint"`
+	// State of the replacement
+	State DriveReplacementState `json:"state"`
+	// OfflineSince is when the operator first saw the drive offline
+	OfflineSince metav1.Time `json:"offlineSince"`
+	// Pod the drive is attached to
+	// +optional
+	Pod string `json:"pod,omitempty"`
+	// PersistentVolumeClaim of the drive that was replaced
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// ReplaceTime is when the PersistentVolumeClaim was deleted
+	// +optional
+	ReplaceTime *metav1.Time `json:"replaceTime,omitempty"`
+	// ObjectsTotalCount is the number of objects to heal on the new drive
+	// +optional
+	ObjectsTotalCount int64 `json:"objectsTotalCount,omitempty"`
+	// ItemsHealed is the number of items healed on the new drive
+	// +optional
+	ItemsHealed int64 `json:"itemsHealed,omitempty"`
+	// ItemsFailed is the number of items that failed to heal on the new drive
+	// +optional
+	ItemsFailed int64 `json:"itemsFailed,omitempty"`
+	// BytesDone is the amount of data healed on the new drive, in bytes
+	// +optional
+	BytesDone int64 `json:"bytesDone,omitempty"`
+}
+
+// PoolMigrationState is the step of the migration of a pool to its replacement
+type PoolMigrationState string
+
+const (
+	// PoolMigrationCreating the replacement pool was added to the spec and is being initialized
+	PoolMigrationCreating PoolMigrationState = "Creating"
+	// PoolMigrationDecommissioning the source pool was removed from the spec and MinIO is draining it
+	PoolMigrationDecommissioning PoolMigrationState = "Decommissioning"
+	// PoolMigrationCompleted the source pool was drained and removed
+	PoolMigrationCompleted PoolMigrationState = "Completed"
+)
+
+// PoolMigrationStatus tracks the replacement of a pool by the pool of its `replaceWith` field
+type PoolMigrationStatus struct {
+	// Source is the name of the pool being replaced
+	Source string `json:"source"`
+	// Target is the name of the replacement pool
+	Target string `json:"target"`
+	// State of the migration
+	State PoolMigrationState `json:"state"`
+	// StartTime is when the replacement pool was added to the spec
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is when the source pool was removed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// LostNodeRecoveryState is the step of the recovery of a pod stuck on a lost node
+type LostNodeRecoveryState string
+
+const (
+	// LostNodeRecoveryDetected the pod is pending because its volumes are on a lost node
+	LostNodeRecoveryDetected LostNodeRecoveryState = "Detected"
+	// LostNodeRecoveryReleased the PersistentVolumeClaims of the pod were deleted and the pod is being rescheduled
+	LostNodeRecoveryReleased LostNodeRecoveryState = "Released"
+)
+
+// LostNodeRecoveryStatus tracks the recovery of a pod stuck on a lost node
+type LostNodeRecoveryStatus struct {
+	// Pod stuck on the lost node
+	Pod string `json:"pod"`
+	// Node the volumes of the pod are pinned to
+	Node string `json:"node"`
+	// State of the recovery
+	State LostNodeRecoveryState `json:"state"`
+	// Since is when the pod got stuck on the lost node
+	Since metav1.Time `json:"since"`
+	// PersistentVolumeClaims of the pod pinned to the lost node
+	// +optional
+	PersistentVolumeClaims []string `json:"persistentVolumeClaims,omitempty"`
+	// ReleaseTime is when the PersistentVolumeClaims were deleted
+	// +optional
+	ReleaseTime *metav1.Time `json:"releaseTime,omitempty"`
+}
+
+// PoolDecommissionStatus tracks the draining of a pool that was removed from the tenant spec
+type PoolDecommissionStatus struct {
+	// Index of the pool in the MinIO arguments when the decommission started
+	Index int32 `json:"index"`
+	// CmdLine is the argument MinIO uses to identify the pool
+	CmdLine string `json:"cmdLine"`
+	// StartTime is when MinIO started decommissioning the pool
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// StartSize is the free space of the pool when the decommission started, in bytes
+	// +optional
+	StartSize int64 `json:"startSize,omitempty"`
+	// TotalSize is the total size of the pool, in bytes
+	// +optional
+	TotalSize int64 `json:"totalSize,omitempty"`
+	// CurrentSize is the current free space of the pool, in bytes
+	// +optional
+	CurrentSize int64 `json:"currentSize,omitempty"`
+	// ObjectsDecommissioned is the number of objects moved to the other pools
+	// +optional
+	ObjectsDecommissioned int64 `json:"objectsDecommissioned,omitempty"`
+	// ObjectsDecommissionFailed is the number of objects that could not be moved
+	// +optional
+	ObjectsDecommissionFailed int64 `json:"objectsDecommissionFailed,omitempty"`
+	// BytesDecommissioned is the amount of data moved to the other pools, in bytes
+	// +optional
+	BytesDecommissioned int64 `json:"bytesDecommissioned,omitempty"`
+	// BytesDecommissionFailed is the amount of data that could not be moved, in bytes
+	// +optional
+	BytesDecommissionFailed int64 `json:"bytesDecommissionFailed,omitempty"`
+	// Complete is set once all the data was drained from the pool
+	// +optional
+	Complete bool `json:"complete,omitempty"`
+	// Failed is set when MinIO reports the decommission failed
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+	// Canceled is set when the decommission was canceled
+	// +optional
+	Canceled bool `json:"canceled,omitempty"`
+	// Retries is the number of times the decommission was restarted after MinIO stopped it
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+	// NextRetryTime is when a failed decommission is restarted
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
+
+// PoolUsage are metrics regarding the usage and capacity of a pool
+type PoolUsage struct {
+	// Capacity the usable capacity of this pool in bytes.
+	// +optional
+	Capacity int64 `json:"capacity,omitempty"`
+	// RawCapacity the raw capacity of this pool in bytes.
+	// +optional
+	RawCapacity int64 `json:"rawCapacity,omitempty"`
+	// Usage is how much data is managed by MinIO in this pool in bytes.
+	// +optional
+	Usage int64 `json:"usage,omitempty"`
+	// RawUsage is the raw usage on the disks of this pool in bytes.
+	// +optional
+	RawUsage int64 `json:"rawUsage,omitempty"`
+}
+
+// UpgradeStrategy represents how the MinIO version of a tenant is upgraded
+type UpgradeStrategy string
+
+const (
+	// UpgradeStrategyInPlace updates the MinIO binary of the running pods
+	UpgradeStrategyInPlace UpgradeStrategy = "InPlace"
+	// UpgradeStrategyRollingRestart restarts the pods of the pools one at a time with the new image
+	UpgradeStrategyRollingRestart UpgradeStrategy = "RollingRestart"
+)
+
+// PersistentVolumeClaimRetentionPolicyType is what happens to the PersistentVolumeClaims of a Tenant
+type PersistentVolumeClaimRetentionPolicyType string
+
+const (
+	// RetainPersistentVolumeClaimRetentionPolicyType keeps the PersistentVolumeClaims
+	RetainPersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Retain"
+	// DeletePersistentVolumeClaimRetentionPolicyType deletes the PersistentVolumeClaims
+	DeletePersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Delete"
+)
+
+// ImageVerificationStatus is the result of the verification of the signatures of a MinIO image
+type ImageVerificationStatus struct {
+	// Image that was verified
+	Image string `json:"image"`
+	// Verified is set when the image carries all the signatures required by the tenant
+	Verified bool `json:"verified"`
+	// Digest of the manifest that was verified, the pools run the image pinned to it
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// Message describing the result of the verification
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Time of the verification
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// RollingUpgradeStatus keeps track of an upgrade using the RollingRestart strategy
+type RollingUpgradeStatus struct {
+	// Image the tenant is being upgraded to
+	Image string `json:"image"`
+	// Pool is the name of the pool being upgraded
+	Pool string `json:"pool"`
+	// Partition of the StatefulSet of the pool being upgraded, pods with an ordinal greater or equal to it run the new image
+	Partition int32 `json:"partition"`
+	// UpdatedPods is the number of pods restarted with the new image
+	// +optional
+	UpdatedPods int32 `json:"updatedPods,omitempty"`
+	// TotalPods is the number of pods of the tenant
+	// +optional
+	TotalPods int32 `json:"totalPods,omitempty"`
+	// StartTime is when the upgrade started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// RebalanceState represents the state of the rebalance of a tenant
+type RebalanceState string
+
+const (
+	// RebalancePending indicates a pool was added and the rebalance will start once all the pools are initialized
+	RebalancePending RebalanceState = "Pending"
+	// RebalanceRunning indicates MinIO is rebalancing the data across the pools
+	RebalanceRunning RebalanceState = "Running"
+	// RebalanceCompleted indicates MinIO finished rebalancing the data
+	RebalanceCompleted RebalanceState = "Completed"
+	// RebalanceStopped indicates the rebalance was stopped from the tenant spec or in MinIO
+	RebalanceStopped RebalanceState = "Stopped"
+	// RebalanceFailed indicates the rebalance failed to start or MinIO reported it failed, it's retried at NextRetryTime
+	RebalanceFailed RebalanceState = "Failed"
+)
+
+// RebalanceStatus keeps track of the rebalance of the data across the pools of a tenant
+type RebalanceStatus struct {
+	// ID identifies the rebalance operation in MinIO
+	// +optional
+	ID string `json:"id,omitempty"`
+	// State of the rebalance
+	State RebalanceState `json:"state"`
+	// StartTime is when the operator started the rebalance
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// StoppedAt is when the rebalance completed or was stopped
+	// +optional
+	StoppedAt *metav1.Time `json:"stoppedAt,omitempty"`
+	// Retries is the number of times the rebalance was restarted after it failed
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+	// NextRetryTime is when a failed rebalance is restarted
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+	// Message describes why the rebalance failed
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Pools reports the progress of the rebalance on each pool
+	// +optional
+	Pools []RebalancePoolStatus `json:"pools,omitempty"`
+}
+
+// RebalancePoolStatus is the progress of the rebalance on a given pool
+type RebalancePoolStatus struct {
+	// ID is the index of the pool in MinIO
+	ID int32 `json:"id"`
+	// Status is the state MinIO reports for the rebalance of the pool: Started (or Active) while it's being
+	// rebalanced, then Completed, Stopped or Failed
+	// +optional
+	Status string `json:"status,omitempty"`
+	// UsedPercentage is the percentage of the pool capacity in use
+	// +optional
+	UsedPercentage int32 `json:"usedPercentage,omitempty"`
+	// Objects is the number of objects rebalanced so far
+	// +optional
+	Objects int64 `json:"objects,omitempty"`
+	// Versions is the number of object versions rebalanced so far
+	// +optional
+	Versions int64 `json:"versions,omitempty"`
+	// Bytes is the amount of data rebalanced so far
+	// +optional
+	Bytes int64 `json:"bytes,omitempty"`
 }
 
 // HealthStatus represents whether the tenant is healthy, with decreased service or offline
@@ -466,6 +899,32 @@ const (
 	HealthStatusYellow HealthStatus = "yellow"
 	// HealthStatusRed indicates the tenant is offline, or lost write quorum
 	HealthStatusRed HealthStatus = "red"
+	// HealthStatusSuspended indicates the tenant was scaled down by `spec.suspend`
+	HealthStatusSuspended HealthStatus = "suspended"
+)
+
+// Condition types reported on the Tenant status
+const (
+	// TenantConditionAvailable indicates the tenant is initialized and able to serve requests
+	TenantConditionAvailable = "Available"
+	// TenantConditionProgressing indicates the operator is provisioning or changing resources of the tenant
+	TenantConditionProgressing = "Progressing"
+	// TenantConditionDegraded indicates the tenant has an error or is running with reduced resilience
+	TenantConditionDegraded = "Degraded"
+	// TenantConditionCertificatesReady indicates all the TLS certificates required by the tenant are issued
+	TenantConditionCertificatesReady = "CertificatesReady"
+	// TenantConditionPoolsInitialized indicates all the pools of the tenant are initialized
+	TenantConditionPoolsInitialized = "PoolsInitialized"
+	// TenantConditionKESReady indicates the KES StatefulSet is up-to-date and all its replicas are ready
+	TenantConditionKESReady = "KESReady"
+	// TenantConditionUpgradeInProgress indicates the MinIO version of the tenant is being updated
+	TenantConditionUpgradeInProgress = "UpgradeInProgress"
+	// TenantConditionUpgradeRolledBack indicates the last upgrade of the MinIO image was reverted to the last known good image
+	TenantConditionUpgradeRolledBack = "UpgradeRolledBack"
+	// TenantConditionVolumeExpansionInProgress indicates PersistentVolumeClaims of the tenant are being expanded
+	TenantConditionVolumeExpansionInProgress = "VolumeExpansionInProgress"
+	// TenantConditionPaused indicates the operator stopped reconciling the tenant because of `spec.paused`
+	TenantConditionPaused = "Paused"
 )
 
 // TierUsage represents the usage from a tier setup by the tenant
@@ -541,6 +1000,72 @@ type TenantStatus struct {
 	//
 	// Information about tenant usage
 	Usage TenantUsage `json:"usage,omitempty"`
+	// *Optional* +
+	//
+	// Progress of the rebalance of the data across the pools of the tenant
+	// +optional
+	Rebalance *RebalanceStatus `json:"rebalance,omitempty"`
+	// *Optional* +
+	//
+	// Progress of an upgrade using the `RollingRestart` strategy
+	// +optional
+	RollingUpgrade *RollingUpgradeStatus `json:"rollingUpgrade,omitempty"`
+	// *Optional* +
+	//
+	// Last MinIO image the tenant ran with a green health
+	// +optional
+	LastKnownGoodImage string `json:"lastKnownGoodImage,omitempty"`
+	// *Optional* +
+	//
+	// Time the upgrade to the image in the spec started, cleared once the tenant is healthy running it
+	// +optional
+	UpgradeStartTime *metav1.Time `json:"upgradeStartTime,omitempty"`
+	// *Optional* +
+	//
+	// MinIO image of an upgrade that was rolled back, the pools run the last known good image while it is the image in the spec
+	// +optional
+	RolledBackImage string `json:"rolledBackImage,omitempty"`
+	// *Optional* +
+	//
+	// Result of the verification of the signatures of the last MinIO image the tenant was upgraded to
+	// +optional
+	ImageVerification *ImageVerificationStatus `json:"imageVerification,omitempty"`
+	// *Optional* +
+	//
+	// Failed drives being replaced when `spec.driveReplacement` is enabled
+	// +optional
+	DriveReplacements []DriveReplacementStatus `json:"driveReplacements,omitempty"`
+	// *Optional* +
+	//
+	// Pods pending because their volumes are on a lost node, when `spec.lostNodeRecovery` is enabled
+	// +optional
+	LostNodeRecoveries []LostNodeRecoveryStatus `json:"lostNodeRecoveries,omitempty"`
+	// *Optional* +
+	//
+	// Time the last pool was added by `spec.autoExpand`, no pool is added again before `spec.autoExpand.cooldown` elapsed
+	// +optional
+	LastAutoExpansionTime *metav1.Time `json:"lastAutoExpansionTime,omitempty"`
+	// *Optional* +
+	//
+	// Pools being replaced by the pool of their `replaceWith` field
+	// +optional
+	PoolMigrations []PoolMigrationStatus `json:"poolMigrations,omitempty"`
+
+	// *Optional* +
+	//
+	// Disruptive operations waiting for a maintenance window to open
+	// +optional
+	PendingMaintenance []PendingMaintenance `json:"pendingMaintenance,omitempty"`
+	// *Optional* +
+	//
+	// Time the next maintenance window opens, while operations are pending
+	// +optional
+	NextMaintenanceWindow *metav1.Time `json:"nextMaintenanceWindow,omitempty"`
+	// *Optional* +
+	//
+	// State of `spec.config` in MinIO
+	// +optional
+	Config *TenantConfigStatus `json:"config,omitempty"`
 
 	// ProvisionedUsers keeps track for telling if operator already created initial users for the tenant
 	// +deprecated
@@ -551,6 +1076,14 @@ type TenantStatus struct {
 	// ProvisionedBuckets keeps track for telling if operator already created initial buckets for the tenant
 	// +deprecated
 	ProvisionedBuckets bool `json:"provisionedBuckets,omitempty"`
+	// *Optional* +
+	//
+	// Conditions represent the latest available observations of the Tenant's state. +
+	// The condition types are `Available`, `Progressing`, `Degraded`, `CertificatesReady`, `PoolsInitialized`, `KESReady`, `UpgradeInProgress`, `UpgradeRolledBack` and `VolumeExpansionInProgress`.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // CertificateConfig (`certConfig`) defines controlling attributes associated to any TLS certificate automatically generated by the Operator as part of tenant creation. These fields have no effect if `spec.autoCert: false`.
@@ -636,7 +1169,14 @@ type Pool struct {
 	//
 	// Specify the configuration options for the MinIO Operator to use when generating Persistent Volume Claims for the MinIO tenant. +
 	//
-	VolumeClaimTemplate *corev1.PersistentVolumeClaim `json:"volumeClaimTemplate"`
+	// Required unless `volumeClaimTemplates` is set. +
+	// +optional
+	VolumeClaimTemplate *corev1.PersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
+	// *Optional* +
+	//
+	// Generate the Persistent Volume Claims of each server from several templates, for example to combine NVMe and HDD drives in the same pool. The counts of the templates must add up to `volumesPerServer`. Can't be combined with `volumeClaimTemplate`. The names, order and counts of the templates of an existing pool can't be changed. +
+	// +optional
+	VolumeClaimTemplates []PoolVolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
 	// *Optional* +
 	//
 	// Object specification for specifying CPU and memory https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/[resource allocations] or limits in the MinIO tenant. +
@@ -709,6 +1249,30 @@ type Pool struct {
 	// If provided, each pod on the Statefulset will run with the specified RuntimeClassName, for more info https://kubernetes.io/docs/concepts/containers/runtime-class/
 	// +optional
 	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// *Optional* +
+	//
+	// Pool that replaces this pool, with the same fields as the entries of `pools`. The Operator adds the new pool to the Tenant, waits for it to be initialized, then removes this pool from the spec so MinIO decommissions it. Use it to move the data of the pool to a new StorageClass or group of nodes. +
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ReplaceWith *Pool `json:"replaceWith,omitempty"`
+}
+
+// PoolVolumeClaimTemplate (`volumeClaimTemplates`) generates some of the Persistent Volume Claims of each server of a pool. +
+type PoolVolumeClaimTemplate struct {
+	// *Required* +
+	//
+	// Number of Persistent Volume Claims of each server generated from the template. +
+	// +kubebuilder:validation:Minimum=1
+	Count int32 `json:"count"`
+	// *Required* +
+	//
+	// Template of the Persistent Volume Claims, with the same fields as `volumeClaimTemplate`. The template must have a name. +
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template corev1.PersistentVolumeClaim `json:"template"`
 }
 
 // EqualImage returns true if config image and current input image are same
@@ -728,6 +1292,210 @@ type AuditConfig struct {
 	DiskCapacityGB *int `json:"diskCapacityGB,omitempty"`
 }
 
+// ImageVerification (`imageVerification`) defines the signatures the MinIO image must carry before the MinIO Operator upgrades the Tenant to it. +
+//
+// When cosign public keys or keyless identities are set, the image must have a cosign signature made by any of them. When a minisign public key is set, the MinIO binary of the image must be signed with it. +
+type ImageVerification struct {
+	// *Optional* +
+	//
+	// PEM encoded public keys accepted for cosign signatures of the image. +
+	// +optional
+	CosignPublicKeys []string `json:"cosignPublicKeys,omitempty"`
+	// *Optional* +
+	//
+	// Identities accepted for cosign keyless signatures of the image. +
+	// +optional
+	CosignKeyless *CosignKeyless `json:"cosignKeyless,omitempty"`
+	// *Optional* +
+	//
+	// Minisign public key the MinIO binary of the image must be signed with. It's also used by MinIO to verify in-place updates. Defaults to the MinIO release key. +
+	// +optional
+	MinisignPublicKey string `json:"minisignPublicKey,omitempty"`
+}
+
+// TenantPersistentVolumeClaimRetentionPolicy (`persistentVolumeClaimRetentionPolicy`) defines what happens to the PersistentVolumeClaims of the Tenant. +
+type TenantPersistentVolumeClaimRetentionPolicy struct {
+	// *Optional* +
+	//
+	// What happens to the PersistentVolumeClaims of all the pools when the Tenant is deleted, `Retain` (default) or `Delete`. +
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +optional
+	WhenDeleted PersistentVolumeClaimRetentionPolicyType `json:"whenDeleted,omitempty"`
+	// *Optional* +
+	//
+	// What happens to the PersistentVolumeClaims of a pool once it is removed from the Tenant and decommissioned, `Retain` (default) or `Delete`. +
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +optional
+	WhenPoolRemoved PersistentVolumeClaimRetentionPolicyType `json:"whenPoolRemoved,omitempty"`
+}
+
+// DriveReplacement (`driveReplacement`) defines the replacement of the drives MinIO reports as failed. +
+type DriveReplacement struct {
+	// *Optional* +
+	//
+	// Enables the replacement of failed drives. When a drive stays offline for longer than `gracePeriod` while its pod is ready and its other drives are online, the MinIO Operator deletes its PersistentVolumeClaim and restarts the pod, so the StatefulSet provisions a new volume that MinIO heals. +
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// *Optional* +
+	//
+	// Time a drive has to be offline before it is replaced. Defaults to `30m`. +
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// LostNodeRecovery (`lostNodeRecovery`) defines the recovery of pods whose local volumes are on a lost node. +
+type LostNodeRecovery struct {
+	// *Optional* +
+	//
+	// Enables the recovery of pods stuck on lost nodes. When a pod stays `Pending` for longer than `timeout` because its PersistentVolumes are pinned to a node that is gone or `NotReady`, the MinIO Operator deletes its PersistentVolumeClaims and the pod, so the StatefulSet recreates them on another node. The data of those volumes is lost and healed by MinIO. Pods are recovered one at a time, and only while the remaining drives of their erasure sets keep write quorum. +
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// *Optional* +
+	//
+	// Time a pod has to be stuck on a lost node before its PersistentVolumeClaims are released. Defaults to `15m`. +
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// AutoExpand (`autoExpand`) defines when and how new pools are added to the Tenant as its usage grows. +
+type AutoExpand struct {
+	// *Optional* +
+	//
+	// Enables the automatic expansion of the Tenant. +
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// *Required* +
+	//
+	// Percentage of the usable capacity of the Tenant in use that triggers the addition of a pool. +
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	UsageThreshold int32 `json:"usageThreshold"`
+	// *Required* +
+	//
+	// Maximum number of pools of the Tenant, no pool is added once the Tenant has this many pools. +
+	// +kubebuilder:validation:Minimum=1
+	MaxPools int32 `json:"maxPools"`
+	// *Required* +
+	//
+	// Pool added to the Tenant, with the same fields as the entries of `pools`. The name of the new pool is the name of the template, `pool` if empty, followed by a number. +
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	PoolTemplate Pool `json:"poolTemplate"`
+	// *Optional* +
+	//
+	// Proposes the new pool on the `min.io/auto-expand-proposal` annotation instead of adding it. The pool is added once the `min.io/auto-expand-approve` annotation is set to the name of the proposed pool. +
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+	// *Optional* +
+	//
+	// Minimum time between two pools added to the Tenant, so MinIO spreads the new data on the last pool before the usage is checked again. Defaults to `1h`. +
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// MaintenanceWindow (`maintenanceWindows`) defines a recurring window in which disruptive operations are allowed. +
+type MaintenanceWindow struct {
+	// *Required* +
+	//
+	// Cron schedule of the start of the window, with the minute, hour, day of month, month and day of week fields, such as `0 2 * * 6` for every Saturday at 02:00. +
+	Schedule string `json:"schedule"`
+	// *Required* +
+	//
+	// Length of the window, such as `4h`. +
+	Duration metav1.Duration `json:"duration"`
+	// *Optional* +
+	//
+	// IANA time zone of the schedule, such as `Europe/Berlin`. Defaults to `UTC`. +
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// TenantConfigStatus is the state of `spec.config` in MinIO
+type TenantConfigStatus struct {
+	// *Optional* +
+	//
+	// Hash of the `spec.config` last applied to MinIO
+	// +optional
+	AppliedHash string `json:"appliedHash,omitempty"`
+	// *Optional* +
+	//
+	// Parameters last found changed in MinIO outside of `spec.config`, as `subsystem parameter`, and restored
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+	// *Optional* +
+	//
+	// Time parameters were last found changed in MinIO outside of `spec.config`
+	// +optional
+	LastDriftTime *metav1.Time `json:"lastDriftTime,omitempty"`
+	// *Optional* +
+	//
+	// MinIO has to restart to apply parameters already set, cleared once the restart succeeds
+	// +optional
+	RestartRequired bool `json:"restartRequired,omitempty"`
+}
+
+// MaintenanceOperation is a disruptive operation that waits for a maintenance window
+type MaintenanceOperation string
+
+// Disruptive operations gated by the maintenance windows
+const (
+	MaintenancePoolRestart       MaintenanceOperation = "PoolRestart"
+	MaintenanceUpgrade           MaintenanceOperation = "Upgrade"
+	MaintenanceVolumeExpansion   MaintenanceOperation = "VolumeExpansion"
+	MaintenanceStatefulSetUpdate MaintenanceOperation = "StatefulSetUpdate"
+	MaintenanceConfigRestart     MaintenanceOperation = "ConfigRestart"
+)
+
+// PendingMaintenance is a disruptive operation waiting for a maintenance window to open
+type PendingMaintenance struct {
+	Operation MaintenanceOperation `json:"operation"`
+	// *Optional* +
+	//
+	// What the operation does
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Time the operation was queued
+	QueuedTime metav1.Time `json:"queuedTime"`
+}
+
+// CosignKeyless (`cosignKeyless`) defines the identities accepted for cosign keyless signatures. +
+type CosignKeyless struct {
+	// *Required* +
+	//
+	// PEM encoded root certificates of the certificate authority issuing the signing certificates, such as Fulcio. +
+	RootCertificates string `json:"rootCertificates"`
+	// *Required* +
+	//
+	// PEM encoded public key of the transparency log, such as Rekor, verifying the transparency log bundle that dates the signature. +
+	TransparencyLogPublicKey string `json:"transparencyLogPublicKey"`
+	// *Required* +
+	//
+	// Identities accepted as signers of the image. +
+	Identities []CosignIdentity `json:"identities"`
+}
+
+// CosignIdentity (`identities`) is an identity accepted for cosign keyless signatures. +
+type CosignIdentity struct {
+	// *Required* +
+	//
+	// The OIDC issuer of the signing certificate, for example `https://token.actions.githubusercontent.com`. +
+	Issuer string `json:"issuer"`
+	// *Required* +
+	//
+	// The subject of the signing certificate, an email address or URI. +
+	Subject string `json:"subject"`
+}
+
+// RebalanceConfig (`rebalance`) defines how the MinIO Operator rebalances the data of the Tenant after a new pool is added. +
+type RebalanceConfig struct {
+	// *Optional* +
+	//
+	// Start a rebalance once a new pool is initialized. Setting it to `false` while a rebalance runs stops it. Defaults to `false`. +
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
 // KESConfig (`kes`) defines the configuration of the https://github.com/minio/kes[MinIO Key Encryption Service] (KES) StatefulSet deployed as part of the MinIO Tenant. KES supports Server-Side Encryption of objects using an external Key Management Service (KMS). +
 type KESConfig struct {
 	// *Optional* +
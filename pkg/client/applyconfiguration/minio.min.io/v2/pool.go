@@ -25,20 +25,22 @@ import (
 // PoolApplyConfiguration represents an declarative configuration of the Pool type for use
 // with apply.
 type PoolApplyConfiguration struct {
-	Name                      *string                       `json:"name,omitempty"`
-	Servers                   *int32                        `json:"servers,omitempty"`
-	VolumesPerServer          *int32                        `json:"volumesPerServer,omitempty"`
-	VolumeClaimTemplate       *v1.PersistentVolumeClaim     `json:"volumeClaimTemplate,omitempty"`
-	Resources                 *v1.ResourceRequirements      `json:"resources,omitempty"`
-	NodeSelector              map[string]string             `json:"nodeSelector,omitempty"`
-	Affinity                  *v1.Affinity                  `json:"affinity,omitempty"`
-	Tolerations               []v1.Toleration               `json:"tolerations,omitempty"`
-	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
-	SecurityContext           *v1.PodSecurityContext        `json:"securityContext,omitempty"`
-	ContainerSecurityContext  *v1.SecurityContext           `json:"containerSecurityContext,omitempty"`
-	Annotations               map[string]string             `json:"annotations,omitempty"`
-	Labels                    map[string]string             `json:"labels,omitempty"`
-	RuntimeClassName          *string                       `json:"runtimeClassName,omitempty"`
+	Name                      *string                                     `json:"name,omitempty"`
+	Servers                   *int32                                      `json:"servers,omitempty"`
+	VolumesPerServer          *int32                                      `json:"volumesPerServer,omitempty"`
+	VolumeClaimTemplate       *v1.PersistentVolumeClaim                   `json:"volumeClaimTemplate,omitempty"`
+	VolumeClaimTemplates      []PoolVolumeClaimTemplateApplyConfiguration `json:"volumeClaimTemplates,omitempty"`
+	Resources                 *v1.ResourceRequirements                    `json:"resources,omitempty"`
+	NodeSelector              map[string]string                           `json:"nodeSelector,omitempty"`
+	Affinity                  *v1.Affinity                                `json:"affinity,omitempty"`
+	Tolerations               []v1.Toleration                             `json:"tolerations,omitempty"`
+	TopologySpreadConstraints []v1.TopologySpreadConstraint               `json:"topologySpreadConstraints,omitempty"`
+	SecurityContext           *v1.PodSecurityContext                      `json:"securityContext,omitempty"`
+	ContainerSecurityContext  *v1.SecurityContext                         `json:"containerSecurityContext,omitempty"`
+	Annotations               map[string]string                           `json:"annotations,omitempty"`
+	Labels                    map[string]string                           `json:"labels,omitempty"`
+	RuntimeClassName          *string                                     `json:"runtimeClassName,omitempty"`
+	ReplaceWith               *PoolApplyConfiguration                     `json:"replaceWith,omitempty"`
 }
 
 // PoolApplyConfiguration constructs an declarative configuration of the Pool type for use with
@@ -79,6 +81,19 @@ func (b *PoolApplyConfiguration) WithVolumeClaimTemplate(value v1.PersistentVolu
 	return b
 }
 
+// WithVolumeClaimTemplates adds the given value to the VolumeClaimTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeClaimTemplates field.
+func (b *PoolApplyConfiguration) WithVolumeClaimTemplates(values ...*PoolVolumeClaimTemplateApplyConfiguration) *PoolApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumeClaimTemplates")
+		}
+		b.VolumeClaimTemplates = append(b.VolumeClaimTemplates, *values[i])
+	}
+	return b
+}
+
 // WithResources sets the Resources field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Resources field is set to the value of the last call.
@@ -180,3 +195,11 @@ func (b *PoolApplyConfiguration) WithRuntimeClassName(value string) *PoolApplyCo
 	b.RuntimeClassName = &value
 	return b
 }
+
+// WithReplaceWith sets the ReplaceWith field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplaceWith field is set to the value of the last call.
+func (b *PoolApplyConfiguration) WithReplaceWith(value *PoolApplyConfiguration) *PoolApplyConfiguration {
+	b.ReplaceWith = value
+	return b
+}
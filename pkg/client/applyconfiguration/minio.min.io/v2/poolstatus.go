@@ -25,9 +25,17 @@ import (
 // PoolStatusApplyConfiguration represents an declarative configuration of the PoolStatus type for use
 // with apply.
 type PoolStatusApplyConfiguration struct {
-	SSName                *string       `json:"ssName,omitempty"`
-	State                 *v2.PoolState `json:"state,omitempty"`
-	LegacySecurityContext *bool         `json:"legacySecurityContext,omitempty"`
+	SSName                *string                                   `json:"ssName,omitempty"`
+	State                 *v2.PoolState                             `json:"state,omitempty"`
+	LegacySecurityContext *bool                                     `json:"legacySecurityContext,omitempty"`
+	ReadyReplicas         *int32                                    `json:"readyReplicas,omitempty"`
+	DrivesOnline          *int32                                    `json:"drivesOnline,omitempty"`
+	DrivesOffline         *int32                                    `json:"drivesOffline,omitempty"`
+	DrivesHealing         *int32                                    `json:"drivesHealing,omitempty"`
+	Parity                *int32                                    `json:"parity,omitempty"`
+	Usage                 *PoolUsageApplyConfiguration              `json:"usage,omitempty"`
+	Decommission          *PoolDecommissionStatusApplyConfiguration `json:"decommission,omitempty"`
+	VolumeResizes         []PVCResizeStatusApplyConfiguration       `json:"volumeResizes,omitempty"`
 }
 
 // PoolStatusApplyConfiguration constructs an declarative configuration of the PoolStatus type for use with
@@ -59,3 +67,72 @@ func (b *PoolStatusApplyConfiguration) WithLegacySecurityContext(value bool) *Po
 	b.LegacySecurityContext = &value
 	return b
 }
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithReadyReplicas(value int32) *PoolStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithDrivesOnline sets the DrivesOnline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrivesOnline field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithDrivesOnline(value int32) *PoolStatusApplyConfiguration {
+	b.DrivesOnline = &value
+	return b
+}
+
+// WithDrivesOffline sets the DrivesOffline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrivesOffline field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithDrivesOffline(value int32) *PoolStatusApplyConfiguration {
+	b.DrivesOffline = &value
+	return b
+}
+
+// WithDrivesHealing sets the DrivesHealing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrivesHealing field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithDrivesHealing(value int32) *PoolStatusApplyConfiguration {
+	b.DrivesHealing = &value
+	return b
+}
+
+// WithParity sets the Parity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parity field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithParity(value int32) *PoolStatusApplyConfiguration {
+	b.Parity = &value
+	return b
+}
+
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithUsage(value *PoolUsageApplyConfiguration) *PoolStatusApplyConfiguration {
+	b.Usage = value
+	return b
+}
+
+// WithDecommission sets the Decommission field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Decommission field is set to the value of the last call.
+func (b *PoolStatusApplyConfiguration) WithDecommission(value *PoolDecommissionStatusApplyConfiguration) *PoolStatusApplyConfiguration {
+	b.Decommission = value
+	return b
+}
+
+// WithVolumeResizes adds the given value to the VolumeResizes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeResizes field.
+func (b *PoolStatusApplyConfiguration) WithVolumeResizes(values ...*PVCResizeStatusApplyConfiguration) *PoolStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumeResizes")
+		}
+		b.VolumeResizes = append(b.VolumeResizes, *values[i])
+	}
+	return b
+}
@@ -22,45 +22,58 @@ import (
 	miniominiov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // TenantSpecApplyConfiguration represents an declarative configuration of the TenantSpec type for use
 // with apply.
 type TenantSpecApplyConfiguration struct {
-	Pools                     []PoolApplyConfiguration                     `json:"pools,omitempty"`
-	Image                     *string                                      `json:"image,omitempty"`
-	ImagePullSecret           *v1.LocalObjectReference                     `json:"imagePullSecret,omitempty"`
-	PodManagementPolicy       *appsv1.PodManagementPolicyType              `json:"podManagementPolicy,omitempty"`
-	Env                       []v1.EnvVar                                  `json:"env,omitempty"`
-	ExternalCertSecret        []*miniominiov2.LocalCertificateReference    `json:"externalCertSecret,omitempty"`
-	ExternalCaCertSecret      []*miniominiov2.LocalCertificateReference    `json:"externalCaCertSecret,omitempty"`
-	ExternalClientCertSecret  *LocalCertificateReferenceApplyConfiguration `json:"externalClientCertSecret,omitempty"`
-	ExternalClientCertSecrets []*miniominiov2.LocalCertificateReference    `json:"externalClientCertSecrets,omitempty"`
-	Mountpath                 *string                                      `json:"mountPath,omitempty"`
-	Subpath                   *string                                      `json:"subPath,omitempty"`
-	RequestAutoCert           *bool                                        `json:"requestAutoCert,omitempty"`
-	CertExpiryAlertThreshold  *int32                                       `json:"certExpiryAlertThreshold,omitempty"`
-	Liveness                  *v1.Probe                                    `json:"liveness,omitempty"`
-	Readiness                 *v1.Probe                                    `json:"readiness,omitempty"`
-	Startup                   *v1.Probe                                    `json:"startup,omitempty"`
-	Lifecycle                 *v1.Lifecycle                                `json:"lifecycle,omitempty"`
-	Features                  *FeaturesApplyConfiguration                  `json:"features,omitempty"`
-	CertConfig                *CertificateConfigApplyConfiguration         `json:"certConfig,omitempty"`
-	KES                       *KESConfigApplyConfiguration                 `json:"kes,omitempty"`
-	PrometheusOperator        *bool                                        `json:"prometheusOperator,omitempty"`
-	ServiceAccountName        *string                                      `json:"serviceAccountName,omitempty"`
-	PriorityClassName         *string                                      `json:"priorityClassName,omitempty"`
-	ImagePullPolicy           *v1.PullPolicy                               `json:"imagePullPolicy,omitempty"`
-	SideCars                  *SideCarsApplyConfiguration                  `json:"sideCars,omitempty"`
-	ExposeServices            *ExposeServicesApplyConfiguration            `json:"exposeServices,omitempty"`
-	ServiceMetadata           *ServiceMetadataApplyConfiguration           `json:"serviceMetadata,omitempty"`
-	Users                     []v1.LocalObjectReference                    `json:"users,omitempty"`
-	Buckets                   []BucketApplyConfiguration                   `json:"buckets,omitempty"`
-	Logging                   *LoggingApplyConfiguration                   `json:"logging,omitempty"`
-	Configuration             *v1.LocalObjectReference                     `json:"configuration,omitempty"`
-	InitContainers            []v1.Container                               `json:"initContainers,omitempty"`
-	AdditionalVolumes         []v1.Volume                                  `json:"additionalVolumes,omitempty"`
-	AdditionalVolumeMounts    []v1.VolumeMount                             `json:"additionalVolumeMounts,omitempty"`
+	Pools                                []PoolApplyConfiguration                                      `json:"pools,omitempty"`
+	Image                                *string                                                       `json:"image,omitempty"`
+	ImagePullSecret                      *v1.LocalObjectReference                                      `json:"imagePullSecret,omitempty"`
+	PodManagementPolicy                  *appsv1.PodManagementPolicyType                               `json:"podManagementPolicy,omitempty"`
+	Env                                  []v1.EnvVar                                                   `json:"env,omitempty"`
+	ExternalCertSecret                   []*miniominiov2.LocalCertificateReference                     `json:"externalCertSecret,omitempty"`
+	ExternalCaCertSecret                 []*miniominiov2.LocalCertificateReference                     `json:"externalCaCertSecret,omitempty"`
+	ExternalClientCertSecret             *LocalCertificateReferenceApplyConfiguration                  `json:"externalClientCertSecret,omitempty"`
+	ExternalClientCertSecrets            []*miniominiov2.LocalCertificateReference                     `json:"externalClientCertSecrets,omitempty"`
+	Mountpath                            *string                                                       `json:"mountPath,omitempty"`
+	Subpath                              *string                                                       `json:"subPath,omitempty"`
+	RequestAutoCert                      *bool                                                         `json:"requestAutoCert,omitempty"`
+	CertExpiryAlertThreshold             *int32                                                        `json:"certExpiryAlertThreshold,omitempty"`
+	Liveness                             *v1.Probe                                                     `json:"liveness,omitempty"`
+	Readiness                            *v1.Probe                                                     `json:"readiness,omitempty"`
+	Startup                              *v1.Probe                                                     `json:"startup,omitempty"`
+	Lifecycle                            *v1.Lifecycle                                                 `json:"lifecycle,omitempty"`
+	Features                             *FeaturesApplyConfiguration                                   `json:"features,omitempty"`
+	CertConfig                           *CertificateConfigApplyConfiguration                          `json:"certConfig,omitempty"`
+	KES                                  *KESConfigApplyConfiguration                                  `json:"kes,omitempty"`
+	Rebalance                            *RebalanceConfigApplyConfiguration                            `json:"rebalance,omitempty"`
+	UpgradeStrategy                      *miniominiov2.UpgradeStrategy                                 `json:"upgradeStrategy,omitempty"`
+	UpgradeRollbackDeadline              *metav1.Duration                                              `json:"upgradeRollbackDeadline,omitempty"`
+	ImageVerification                    *ImageVerificationApplyConfiguration                          `json:"imageVerification,omitempty"`
+	PersistentVolumeClaimRetentionPolicy *TenantPersistentVolumeClaimRetentionPolicyApplyConfiguration `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+	DriveReplacement                     *DriveReplacementApplyConfiguration                           `json:"driveReplacement,omitempty"`
+	LostNodeRecovery                     *LostNodeRecoveryApplyConfiguration                           `json:"lostNodeRecovery,omitempty"`
+	Paused                               *bool                                                         `json:"paused,omitempty"`
+	Suspend                              *bool                                                         `json:"suspend,omitempty"`
+	MaintenanceWindows                   []MaintenanceWindowApplyConfiguration                         `json:"maintenanceWindows,omitempty"`
+	AutoExpand                           *AutoExpandApplyConfiguration                                 `json:"autoExpand,omitempty"`
+	PrometheusOperator                   *bool                                                         `json:"prometheusOperator,omitempty"`
+	ServiceAccountName                   *string                                                       `json:"serviceAccountName,omitempty"`
+	PriorityClassName                    *string                                                       `json:"priorityClassName,omitempty"`
+	ImagePullPolicy                      *v1.PullPolicy                                                `json:"imagePullPolicy,omitempty"`
+	SideCars                             *SideCarsApplyConfiguration                                   `json:"sideCars,omitempty"`
+	ExposeServices                       *ExposeServicesApplyConfiguration                             `json:"exposeServices,omitempty"`
+	ServiceMetadata                      *ServiceMetadataApplyConfiguration                            `json:"serviceMetadata,omitempty"`
+	Users                                []v1.LocalObjectReference                                     `json:"users,omitempty"`
+	Buckets                              []BucketApplyConfiguration                                    `json:"buckets,omitempty"`
+	Logging                              *LoggingApplyConfiguration                                    `json:"logging,omitempty"`
+	Configuration                        *v1.LocalObjectReference                                      `json:"configuration,omitempty"`
+	Config                               map[string]map[string]string                                  `json:"config,omitempty"`
+	InitContainers                       []v1.Container                                                `json:"initContainers,omitempty"`
+	AdditionalVolumes                    []v1.Volume                                                   `json:"additionalVolumes,omitempty"`
+	AdditionalVolumeMounts               []v1.VolumeMount                                              `json:"additionalVolumeMounts,omitempty"`
 }
 
 // TenantSpecApplyConfiguration constructs an declarative configuration of the TenantSpec type for use with
@@ -251,6 +264,99 @@ func (b *TenantSpecApplyConfiguration) WithKES(value *KESConfigApplyConfiguratio
 	return b
 }
 
+// WithRebalance sets the Rebalance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rebalance field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithRebalance(value *RebalanceConfigApplyConfiguration) *TenantSpecApplyConfiguration {
+	b.Rebalance = value
+	return b
+}
+
+// WithUpgradeStrategy sets the UpgradeStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeStrategy field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithUpgradeStrategy(value miniominiov2.UpgradeStrategy) *TenantSpecApplyConfiguration {
+	b.UpgradeStrategy = &value
+	return b
+}
+
+// WithUpgradeRollbackDeadline sets the UpgradeRollbackDeadline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeRollbackDeadline field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithUpgradeRollbackDeadline(value metav1.Duration) *TenantSpecApplyConfiguration {
+	b.UpgradeRollbackDeadline = &value
+	return b
+}
+
+// WithImageVerification sets the ImageVerification field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImageVerification field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithImageVerification(value *ImageVerificationApplyConfiguration) *TenantSpecApplyConfiguration {
+	b.ImageVerification = value
+	return b
+}
+
+// WithPersistentVolumeClaimRetentionPolicy sets the PersistentVolumeClaimRetentionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaimRetentionPolicy field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithPersistentVolumeClaimRetentionPolicy(value *TenantPersistentVolumeClaimRetentionPolicyApplyConfiguration) *TenantSpecApplyConfiguration {
+	b.PersistentVolumeClaimRetentionPolicy = value
+	return b
+}
+
+// WithDriveReplacement sets the DriveReplacement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DriveReplacement field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithDriveReplacement(value *DriveReplacementApplyConfiguration) *TenantSpecApplyConfiguration {
+	b.DriveReplacement = value
+	return b
+}
+
+// WithLostNodeRecovery sets the LostNodeRecovery field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LostNodeRecovery field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithLostNodeRecovery(value *LostNodeRecoveryApplyConfiguration) *TenantSpecApplyConfiguration {
+	b.LostNodeRecovery = value
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithPaused(value bool) *TenantSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithSuspend(value bool) *TenantSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithMaintenanceWindows adds the given value to the MaintenanceWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceWindows field.
+func (b *TenantSpecApplyConfiguration) WithMaintenanceWindows(values ...*MaintenanceWindowApplyConfiguration) *TenantSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMaintenanceWindows")
+		}
+		b.MaintenanceWindows = append(b.MaintenanceWindows, *values[i])
+	}
+	return b
+}
+
+// WithAutoExpand sets the AutoExpand field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoExpand field is set to the value of the last call.
+func (b *TenantSpecApplyConfiguration) WithAutoExpand(value *AutoExpandApplyConfiguration) *TenantSpecApplyConfiguration {
+	b.AutoExpand = value
+	return b
+}
+
 // WithPrometheusOperator sets the PrometheusOperator field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PrometheusOperator field is set to the value of the last call.
@@ -346,6 +452,20 @@ func (b *TenantSpecApplyConfiguration) WithConfiguration(value v1.LocalObjectRef
 	return b
 }
 
+// WithConfig puts the entries into the Config field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Config field,
+// overwriting an existing map entries in Config field with the same key.
+func (b *TenantSpecApplyConfiguration) WithConfig(entries map[string]map[string]string) *TenantSpecApplyConfiguration {
+	if b.Config == nil && len(entries) > 0 {
+		b.Config = make(map[string]map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Config[k] = v
+	}
+	return b
+}
+
 // WithInitContainers adds the given value to the InitContainers field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the InitContainers field.
@@ -21,27 +21,42 @@ package v2
 import (
 	miniominiov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // TenantStatusApplyConfiguration represents an declarative configuration of the TenantStatus type for use
 // with apply.
 type TenantStatusApplyConfiguration struct {
-	CurrentState       *string                              `json:"currentState,omitempty"`
-	AvailableReplicas  *int32                               `json:"availableReplicas,omitempty"`
-	Revision           *int32                               `json:"revision,omitempty"`
-	SyncVersion        *string                              `json:"syncVersion,omitempty"`
-	Certificates       *CertificateStatusApplyConfiguration `json:"certificates,omitempty"`
-	Pools              []PoolStatusApplyConfiguration       `json:"pools,omitempty"`
-	WriteQuorum        *int32                               `json:"writeQuorum,omitempty"`
-	DrivesOnline       *int32                               `json:"drivesOnline,omitempty"`
-	DrivesOffline      *int32                               `json:"drivesOffline,omitempty"`
-	DrivesHealing      *int32                               `json:"drivesHealing,omitempty"`
-	HealthStatus       *miniominiov2.HealthStatus           `json:"healthStatus,omitempty"`
-	HealthMessage      *string                              `json:"healthMessage,omitempty"`
-	WaitingOnReady     *v1.Time                             `json:"waitingOnReady,omitempty"`
-	Usage              *TenantUsageApplyConfiguration       `json:"usage,omitempty"`
-	ProvisionedUsers   *bool                                `json:"provisionedUsers,omitempty"`
-	ProvisionedBuckets *bool                                `json:"provisionedBuckets,omitempty"`
+	CurrentState          *string                                    `json:"currentState,omitempty"`
+	AvailableReplicas     *int32                                     `json:"availableReplicas,omitempty"`
+	Revision              *int32                                     `json:"revision,omitempty"`
+	SyncVersion           *string                                    `json:"syncVersion,omitempty"`
+	Certificates          *CertificateStatusApplyConfiguration       `json:"certificates,omitempty"`
+	Pools                 []PoolStatusApplyConfiguration             `json:"pools,omitempty"`
+	WriteQuorum           *int32                                     `json:"writeQuorum,omitempty"`
+	DrivesOnline          *int32                                     `json:"drivesOnline,omitempty"`
+	DrivesOffline         *int32                                     `json:"drivesOffline,omitempty"`
+	DrivesHealing         *int32                                     `json:"drivesHealing,omitempty"`
+	HealthStatus          *miniominiov2.HealthStatus                 `json:"healthStatus,omitempty"`
+	HealthMessage         *string                                    `json:"healthMessage,omitempty"`
+	WaitingOnReady        *v1.Time                                   `json:"waitingOnReady,omitempty"`
+	Usage                 *TenantUsageApplyConfiguration             `json:"usage,omitempty"`
+	Rebalance             *RebalanceStatusApplyConfiguration         `json:"rebalance,omitempty"`
+	RollingUpgrade        *RollingUpgradeStatusApplyConfiguration    `json:"rollingUpgrade,omitempty"`
+	LastKnownGoodImage    *string                                    `json:"lastKnownGoodImage,omitempty"`
+	UpgradeStartTime      *v1.Time                                   `json:"upgradeStartTime,omitempty"`
+	RolledBackImage       *string                                    `json:"rolledBackImage,omitempty"`
+	ImageVerification     *ImageVerificationStatusApplyConfiguration `json:"imageVerification,omitempty"`
+	DriveReplacements     []DriveReplacementStatusApplyConfiguration `json:"driveReplacements,omitempty"`
+	LostNodeRecoveries    []LostNodeRecoveryStatusApplyConfiguration `json:"lostNodeRecoveries,omitempty"`
+	LastAutoExpansionTime *v1.Time                                   `json:"lastAutoExpansionTime,omitempty"`
+	PoolMigrations        []PoolMigrationStatusApplyConfiguration    `json:"poolMigrations,omitempty"`
+	PendingMaintenance    []PendingMaintenanceApplyConfiguration     `json:"pendingMaintenance,omitempty"`
+	NextMaintenanceWindow *v1.Time                                   `json:"nextMaintenanceWindow,omitempty"`
+	Config                *TenantConfigStatusApplyConfiguration      `json:"config,omitempty"`
+	ProvisionedUsers      *bool                                      `json:"provisionedUsers,omitempty"`
+	ProvisionedBuckets    *bool                                      `json:"provisionedBuckets,omitempty"`
+	Conditions            []metav1.ConditionApplyConfiguration       `json:"conditions,omitempty"`
 }
 
 // TenantStatusApplyConfiguration constructs an declarative configuration of the TenantStatus type for use with
@@ -167,6 +182,130 @@ func (b *TenantStatusApplyConfiguration) WithUsage(value *TenantUsageApplyConfig
 	return b
 }
 
+// WithRebalance sets the Rebalance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rebalance field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithRebalance(value *RebalanceStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	b.Rebalance = value
+	return b
+}
+
+// WithRollingUpgrade sets the RollingUpgrade field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollingUpgrade field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithRollingUpgrade(value *RollingUpgradeStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	b.RollingUpgrade = value
+	return b
+}
+
+// WithLastKnownGoodImage sets the LastKnownGoodImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastKnownGoodImage field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithLastKnownGoodImage(value string) *TenantStatusApplyConfiguration {
+	b.LastKnownGoodImage = &value
+	return b
+}
+
+// WithUpgradeStartTime sets the UpgradeStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeStartTime field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithUpgradeStartTime(value v1.Time) *TenantStatusApplyConfiguration {
+	b.UpgradeStartTime = &value
+	return b
+}
+
+// WithRolledBackImage sets the RolledBackImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolledBackImage field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithRolledBackImage(value string) *TenantStatusApplyConfiguration {
+	b.RolledBackImage = &value
+	return b
+}
+
+// WithImageVerification sets the ImageVerification field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImageVerification field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithImageVerification(value *ImageVerificationStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	b.ImageVerification = value
+	return b
+}
+
+// WithDriveReplacements adds the given value to the DriveReplacements field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DriveReplacements field.
+func (b *TenantStatusApplyConfiguration) WithDriveReplacements(values ...*DriveReplacementStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDriveReplacements")
+		}
+		b.DriveReplacements = append(b.DriveReplacements, *values[i])
+	}
+	return b
+}
+
+// WithLostNodeRecoveries adds the given value to the LostNodeRecoveries field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LostNodeRecoveries field.
+func (b *TenantStatusApplyConfiguration) WithLostNodeRecoveries(values ...*LostNodeRecoveryStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLostNodeRecoveries")
+		}
+		b.LostNodeRecoveries = append(b.LostNodeRecoveries, *values[i])
+	}
+	return b
+}
+
+// WithLastAutoExpansionTime sets the LastAutoExpansionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastAutoExpansionTime field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithLastAutoExpansionTime(value v1.Time) *TenantStatusApplyConfiguration {
+	b.LastAutoExpansionTime = &value
+	return b
+}
+
+// WithPoolMigrations adds the given value to the PoolMigrations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PoolMigrations field.
+func (b *TenantStatusApplyConfiguration) WithPoolMigrations(values ...*PoolMigrationStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPoolMigrations")
+		}
+		b.PoolMigrations = append(b.PoolMigrations, *values[i])
+	}
+	return b
+}
+
+// WithPendingMaintenance adds the given value to the PendingMaintenance field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PendingMaintenance field.
+func (b *TenantStatusApplyConfiguration) WithPendingMaintenance(values ...*PendingMaintenanceApplyConfiguration) *TenantStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPendingMaintenance")
+		}
+		b.PendingMaintenance = append(b.PendingMaintenance, *values[i])
+	}
+	return b
+}
+
+// WithNextMaintenanceWindow sets the NextMaintenanceWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextMaintenanceWindow field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithNextMaintenanceWindow(value v1.Time) *TenantStatusApplyConfiguration {
+	b.NextMaintenanceWindow = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *TenantStatusApplyConfiguration) WithConfig(value *TenantConfigStatusApplyConfiguration) *TenantStatusApplyConfiguration {
+	b.Config = value
+	return b
+}
+
 // WithProvisionedUsers sets the ProvisionedUsers field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ProvisionedUsers field is set to the value of the last call.
@@ -182,3 +321,16 @@ func (b *TenantStatusApplyConfiguration) WithProvisionedBuckets(value bool) *Ten
 	b.ProvisionedBuckets = &value
 	return b
 }
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *TenantStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *TenantStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
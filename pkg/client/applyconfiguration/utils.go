@@ -19,11 +19,15 @@
 package applyconfiguration
 
 import (
-	v1alpha1 "github.com/minio/operator/pkg/apis/job.min.io/v1alpha1"
+	v1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	iamminiov1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	jobminiov1alpha1 "github.com/minio/operator/pkg/apis/job.min.io/v1alpha1"
 	v2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	stsminiov1alpha1 "github.com/minio/operator/pkg/apis/sts.min.io/v1alpha1"
 	v1beta1 "github.com/minio/operator/pkg/apis/sts.min.io/v1beta1"
-	jobminiov1alpha1 "github.com/minio/operator/pkg/client/applyconfiguration/job.min.io/v1alpha1"
+	bucketminiov1alpha1 "github.com/minio/operator/pkg/client/applyconfiguration/bucket.min.io/v1alpha1"
+	applyconfigurationiamminiov1alpha1 "github.com/minio/operator/pkg/client/applyconfiguration/iam.min.io/v1alpha1"
+	applyconfigurationjobminiov1alpha1 "github.com/minio/operator/pkg/client/applyconfiguration/job.min.io/v1alpha1"
 	miniominiov2 "github.com/minio/operator/pkg/client/applyconfiguration/minio.min.io/v2"
 	applyconfigurationstsminiov1alpha1 "github.com/minio/operator/pkg/client/applyconfiguration/sts.min.io/v1alpha1"
 	stsminiov1beta1 "github.com/minio/operator/pkg/client/applyconfiguration/sts.min.io/v1beta1"
@@ -34,53 +38,139 @@ import (
 // apply configuration type exists for the given GroupVersionKind.
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
-	// Group=job.min.io, Version=v1alpha1
-	case v1alpha1.SchemeGroupVersion.WithKind("CommandSpec"):
-		return &jobminiov1alpha1.CommandSpecApplyConfiguration{}
-	case v1alpha1.SchemeGroupVersion.WithKind("CommandStatus"):
-		return &jobminiov1alpha1.CommandStatusApplyConfiguration{}
-	case v1alpha1.SchemeGroupVersion.WithKind("MinIOJob"):
-		return &jobminiov1alpha1.MinIOJobApplyConfiguration{}
-	case v1alpha1.SchemeGroupVersion.WithKind("MinIOJobSpec"):
-		return &jobminiov1alpha1.MinIOJobSpecApplyConfiguration{}
-	case v1alpha1.SchemeGroupVersion.WithKind("MinIOJobStatus"):
-		return &jobminiov1alpha1.MinIOJobStatusApplyConfiguration{}
-	case v1alpha1.SchemeGroupVersion.WithKind("TenantRef"):
-		return &jobminiov1alpha1.TenantRefApplyConfiguration{}
+	// Group=bucket.min.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("Bucket"):
+		return &bucketminiov1alpha1.BucketApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BucketRetention"):
+		return &bucketminiov1alpha1.BucketRetentionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BucketSpec"):
+		return &bucketminiov1alpha1.BucketSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BucketStatus"):
+		return &bucketminiov1alpha1.BucketStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TenantReference"):
+		return &bucketminiov1alpha1.TenantReferenceApplyConfiguration{}
+
+		// Group=iam.min.io, Version=v1alpha1
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("AccessKeyRotation"):
+		return &applyconfigurationiamminiov1alpha1.AccessKeyRotationApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOAccessKey"):
+		return &applyconfigurationiamminiov1alpha1.MinIOAccessKeyApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOAccessKeySpec"):
+		return &applyconfigurationiamminiov1alpha1.MinIOAccessKeySpecApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOAccessKeyStatus"):
+		return &applyconfigurationiamminiov1alpha1.MinIOAccessKeyStatusApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOGroup"):
+		return &applyconfigurationiamminiov1alpha1.MinIOGroupApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOGroupSpec"):
+		return &applyconfigurationiamminiov1alpha1.MinIOGroupSpecApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOPolicy"):
+		return &applyconfigurationiamminiov1alpha1.MinIOPolicyApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOPolicySpec"):
+		return &applyconfigurationiamminiov1alpha1.MinIOPolicySpecApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOUser"):
+		return &applyconfigurationiamminiov1alpha1.MinIOUserApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOUserSpec"):
+		return &applyconfigurationiamminiov1alpha1.MinIOUserSpecApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("MinIOUserStatus"):
+		return &applyconfigurationiamminiov1alpha1.MinIOUserStatusApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("RetiredAccessKey"):
+		return &applyconfigurationiamminiov1alpha1.RetiredAccessKeyApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("SyncStatus"):
+		return &applyconfigurationiamminiov1alpha1.SyncStatusApplyConfiguration{}
+	case iamminiov1alpha1.SchemeGroupVersion.WithKind("TenantReference"):
+		return &applyconfigurationiamminiov1alpha1.TenantReferenceApplyConfiguration{}
+
+		// Group=job.min.io, Version=v1alpha1
+	case jobminiov1alpha1.SchemeGroupVersion.WithKind("CommandSpec"):
+		return &applyconfigurationjobminiov1alpha1.CommandSpecApplyConfiguration{}
+	case jobminiov1alpha1.SchemeGroupVersion.WithKind("CommandStatus"):
+		return &applyconfigurationjobminiov1alpha1.CommandStatusApplyConfiguration{}
+	case jobminiov1alpha1.SchemeGroupVersion.WithKind("MinIOJob"):
+		return &applyconfigurationjobminiov1alpha1.MinIOJobApplyConfiguration{}
+	case jobminiov1alpha1.SchemeGroupVersion.WithKind("MinIOJobSpec"):
+		return &applyconfigurationjobminiov1alpha1.MinIOJobSpecApplyConfiguration{}
+	case jobminiov1alpha1.SchemeGroupVersion.WithKind("MinIOJobStatus"):
+		return &applyconfigurationjobminiov1alpha1.MinIOJobStatusApplyConfiguration{}
+	case jobminiov1alpha1.SchemeGroupVersion.WithKind("TenantRef"):
+		return &applyconfigurationjobminiov1alpha1.TenantRefApplyConfiguration{}
 
 		// Group=minio.min.io, Version=v2
+	case v2.SchemeGroupVersion.WithKind("AutoExpand"):
+		return &miniominiov2.AutoExpandApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("Bucket"):
 		return &miniominiov2.BucketApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("CertificateConfig"):
 		return &miniominiov2.CertificateConfigApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("CertificateStatus"):
 		return &miniominiov2.CertificateStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("CosignIdentity"):
+		return &miniominiov2.CosignIdentityApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("CosignKeyless"):
+		return &miniominiov2.CosignKeylessApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("CustomCertificateConfig"):
 		return &miniominiov2.CustomCertificateConfigApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("CustomCertificates"):
 		return &miniominiov2.CustomCertificatesApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DriveReplacement"):
+		return &miniominiov2.DriveReplacementApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DriveReplacementStatus"):
+		return &miniominiov2.DriveReplacementStatusApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("ExposeServices"):
 		return &miniominiov2.ExposeServicesApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("Features"):
 		return &miniominiov2.FeaturesApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("ImageVerification"):
+		return &miniominiov2.ImageVerificationApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("ImageVerificationStatus"):
+		return &miniominiov2.ImageVerificationStatusApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("KESConfig"):
 		return &miniominiov2.KESConfigApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("LocalCertificateReference"):
 		return &miniominiov2.LocalCertificateReferenceApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("Logging"):
 		return &miniominiov2.LoggingApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("LostNodeRecovery"):
+		return &miniominiov2.LostNodeRecoveryApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("LostNodeRecoveryStatus"):
+		return &miniominiov2.LostNodeRecoveryStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &miniominiov2.MaintenanceWindowApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("PendingMaintenance"):
+		return &miniominiov2.PendingMaintenanceApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("Pool"):
 		return &miniominiov2.PoolApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("PoolDecommissionStatus"):
+		return &miniominiov2.PoolDecommissionStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("PoolMigrationStatus"):
+		return &miniominiov2.PoolMigrationStatusApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("PoolStatus"):
 		return &miniominiov2.PoolStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("PoolUsage"):
+		return &miniominiov2.PoolUsageApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("PoolVolumeClaimTemplate"):
+		return &miniominiov2.PoolVolumeClaimTemplateApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("PVCResizeStatus"):
+		return &miniominiov2.PVCResizeStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("RebalanceConfig"):
+		return &miniominiov2.RebalanceConfigApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("RebalancePoolStatus"):
+		return &miniominiov2.RebalancePoolStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("RebalanceStatus"):
+		return &miniominiov2.RebalanceStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("RollingUpgradeStatus"):
+		return &miniominiov2.RollingUpgradeStatusApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("ServiceMetadata"):
 		return &miniominiov2.ServiceMetadataApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("SideCars"):
 		return &miniominiov2.SideCarsApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("Tenant"):
 		return &miniominiov2.TenantApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("TenantConfigStatus"):
+		return &miniominiov2.TenantConfigStatusApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("TenantDomains"):
 		return &miniominiov2.TenantDomainsApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("TenantPersistentVolumeClaimRetentionPolicy"):
+		return &miniominiov2.TenantPersistentVolumeClaimRetentionPolicyApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("TenantScheduler"):
 		return &miniominiov2.TenantSchedulerApplyConfiguration{}
 	case v2.SchemeGroupVersion.WithKind("TenantSpec"):
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"net/http"
 
+	bucketv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/bucket.min.io/v1alpha1"
+	iamv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/iam.min.io/v1alpha1"
 	jobv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/job.min.io/v1alpha1"
 	miniov2 "github.com/minio/operator/pkg/client/clientset/versioned/typed/minio.min.io/v2"
 	stsv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/sts.min.io/v1alpha1"
@@ -33,6 +35,8 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	BucketV1alpha1() bucketv1alpha1.BucketV1alpha1Interface
+	IamV1alpha1() iamv1alpha1.IamV1alpha1Interface
 	JobV1alpha1() jobv1alpha1.JobV1alpha1Interface
 	MinioV2() miniov2.MinioV2Interface
 	StsV1alpha1() stsv1alpha1.StsV1alpha1Interface
@@ -42,10 +46,22 @@ type Interface interface {
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
-	jobV1alpha1 *jobv1alpha1.JobV1alpha1Client
-	minioV2     *miniov2.MinioV2Client
-	stsV1alpha1 *stsv1alpha1.StsV1alpha1Client
-	stsV1beta1  *stsv1beta1.StsV1beta1Client
+	bucketV1alpha1 *bucketv1alpha1.BucketV1alpha1Client
+	iamV1alpha1    *iamv1alpha1.IamV1alpha1Client
+	jobV1alpha1    *jobv1alpha1.JobV1alpha1Client
+	minioV2        *miniov2.MinioV2Client
+	stsV1alpha1    *stsv1alpha1.StsV1alpha1Client
+	stsV1beta1     *stsv1beta1.StsV1beta1Client
+}
+
+// BucketV1alpha1 retrieves the BucketV1alpha1Client
+func (c *Clientset) BucketV1alpha1() bucketv1alpha1.BucketV1alpha1Interface {
+	return c.bucketV1alpha1
+}
+
+// IamV1alpha1 retrieves the IamV1alpha1Client
+func (c *Clientset) IamV1alpha1() iamv1alpha1.IamV1alpha1Interface {
+	return c.iamV1alpha1
 }
 
 // JobV1alpha1 retrieves the JobV1alpha1Client
@@ -112,6 +128,14 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.bucketV1alpha1, err = bucketv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.iamV1alpha1, err = iamv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.jobV1alpha1, err = jobv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -149,6 +173,8 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.bucketV1alpha1 = bucketv1alpha1.New(c)
+	cs.iamV1alpha1 = iamv1alpha1.New(c)
 	cs.jobV1alpha1 = jobv1alpha1.New(c)
 	cs.minioV2 = miniov2.New(c)
 	cs.stsV1alpha1 = stsv1alpha1.New(c)
@@ -20,6 +20,10 @@ package fake
 
 import (
 	clientset "github.com/minio/operator/pkg/client/clientset/versioned"
+	bucketv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/bucket.min.io/v1alpha1"
+	fakebucketv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/bucket.min.io/v1alpha1/fake"
+	iamv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/iam.min.io/v1alpha1"
+	fakeiamv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/iam.min.io/v1alpha1/fake"
 	jobv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/job.min.io/v1alpha1"
 	fakejobv1alpha1 "github.com/minio/operator/pkg/client/clientset/versioned/typed/job.min.io/v1alpha1/fake"
 	miniov2 "github.com/minio/operator/pkg/client/clientset/versioned/typed/minio.min.io/v2"
@@ -85,6 +89,16 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// BucketV1alpha1 retrieves the BucketV1alpha1Client
+func (c *Clientset) BucketV1alpha1() bucketv1alpha1.BucketV1alpha1Interface {
+	return &fakebucketv1alpha1.FakeBucketV1alpha1{Fake: &c.Fake}
+}
+
+// IamV1alpha1 retrieves the IamV1alpha1Client
+func (c *Clientset) IamV1alpha1() iamv1alpha1.IamV1alpha1Interface {
+	return &fakeiamv1alpha1.FakeIamV1alpha1{Fake: &c.Fake}
+}
+
 // JobV1alpha1 retrieves the JobV1alpha1Client
 func (c *Clientset) JobV1alpha1() jobv1alpha1.JobV1alpha1Interface {
 	return &fakejobv1alpha1.FakeJobV1alpha1{Fake: &c.Fake}
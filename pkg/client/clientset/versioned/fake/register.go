@@ -19,6 +19,8 @@
 package fake
 
 import (
+	bucketv1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
 	jobv1alpha1 "github.com/minio/operator/pkg/apis/job.min.io/v1alpha1"
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	stsv1alpha1 "github.com/minio/operator/pkg/apis/sts.min.io/v1alpha1"
@@ -34,6 +36,8 @@ var scheme = runtime.NewScheme()
 var codecs = serializer.NewCodecFactory(scheme)
 
 var localSchemeBuilder = runtime.SchemeBuilder{
+	bucketv1alpha1.AddToScheme,
+	iamv1alpha1.AddToScheme,
 	jobv1alpha1.AddToScheme,
 	miniov2.AddToScheme,
 	stsv1alpha1.AddToScheme,
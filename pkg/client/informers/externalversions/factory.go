@@ -24,6 +24,8 @@ import (
 	time "time"
 
 	versioned "github.com/minio/operator/pkg/client/clientset/versioned"
+	bucketminio "github.com/minio/operator/pkg/client/informers/externalversions/bucket.min.io"
+	iamminio "github.com/minio/operator/pkg/client/informers/externalversions/iam.min.io"
 	internalinterfaces "github.com/minio/operator/pkg/client/informers/externalversions/internalinterfaces"
 	jobminio "github.com/minio/operator/pkg/client/informers/externalversions/job.min.io"
 	miniominio "github.com/minio/operator/pkg/client/informers/externalversions/minio.min.io"
@@ -255,11 +257,21 @@ type SharedInformerFactory interface {
 	// client.
 	InformerFor(obj runtime.Object, newFunc internalinterfaces.NewInformerFunc) cache.SharedIndexInformer
 
+	Bucket() bucketminio.Interface
+	Iam() iamminio.Interface
 	Job() jobminio.Interface
 	Minio() miniominio.Interface
 	Sts() stsminio.Interface
 }
 
+func (f *sharedInformerFactory) Bucket() bucketminio.Interface {
+	return bucketminio.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Iam() iamminio.Interface {
+	return iamminio.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Job() jobminio.Interface {
 	return jobminio.New(f, f.namespace, f.tweakListOptions)
 }
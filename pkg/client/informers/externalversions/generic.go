@@ -21,7 +21,9 @@ package externalversions
 import (
 	"fmt"
 
-	v1alpha1 "github.com/minio/operator/pkg/apis/job.min.io/v1alpha1"
+	v1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	iamminiov1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	jobminiov1alpha1 "github.com/minio/operator/pkg/apis/job.min.io/v1alpha1"
 	v2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	stsminiov1alpha1 "github.com/minio/operator/pkg/apis/sts.min.io/v1alpha1"
 	v1beta1 "github.com/minio/operator/pkg/apis/sts.min.io/v1beta1"
@@ -55,8 +57,22 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=job.min.io, Version=v1alpha1
-	case v1alpha1.SchemeGroupVersion.WithResource("miniojobs"):
+	// Group=bucket.min.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("buckets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Bucket().V1alpha1().Buckets().Informer()}, nil
+
+		// Group=iam.min.io, Version=v1alpha1
+	case iamminiov1alpha1.SchemeGroupVersion.WithResource("minioaccesskeys"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Iam().V1alpha1().MinIOAccessKeys().Informer()}, nil
+	case iamminiov1alpha1.SchemeGroupVersion.WithResource("miniogroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Iam().V1alpha1().MinIOGroups().Informer()}, nil
+	case iamminiov1alpha1.SchemeGroupVersion.WithResource("miniopolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Iam().V1alpha1().MinIOPolicies().Informer()}, nil
+	case iamminiov1alpha1.SchemeGroupVersion.WithResource("miniousers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Iam().V1alpha1().MinIOUsers().Informer()}, nil
+
+		// Group=job.min.io, Version=v1alpha1
+	case jobminiov1alpha1.SchemeGroupVersion.WithResource("miniojobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Job().V1alpha1().MinIOJobs().Informer()}, nil
 
 		// Group=minio.min.io, Version=v2
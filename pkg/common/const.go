@@ -27,3 +27,11 @@ const (
 	SidecarAPIVersion        = "/sidecar/v1"
 	SidecarAPIConfigEndpoint = SidecarAPIVersion + "/config"
 )
+
+// Constants for the admission webhook endpoints
+const (
+	AdmissionServerPort        = "4225"
+	AdmissionAPIVersion        = "/admission/v1"
+	AdmissionAPIValidateTenant = AdmissionAPIVersion + "/validate/tenant"
+	AdmissionAPIDefaultTenant  = AdmissionAPIVersion + "/default/tenant"
+)
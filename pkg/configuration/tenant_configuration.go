@@ -93,7 +93,7 @@ func buildTenantEnvs(tenant *miniov2.Tenant, cfgEnvExisting map[string]corev1.En
 		},
 		"MINIO_UPDATE_MINISIGN_PUBKEY": {
 			Name:  "MINIO_UPDATE_MINISIGN_PUBKEY",
-			Value: "RWTx5Zr1tiHQLwG9keckT0c45M3AGeHD6IvimQHpyRywVWGbP1aVSGav",
+			Value: tenant.MinisignPublicKey(),
 		},
 		"MINIO_PROMETHEUS_JOB_ID": {
 			Name:  "MINIO_PROMETHEUS_JOB_ID",
@@ -101,7 +101,7 @@ func TestGetTenantConfiguration(t *testing.T) {
 				},
 				{
 					Name:  "MINIO_SERVER_URL",
-					Value: "https://minio..svc.cluster.local:443",
+					Value: "https://..svc.cluster.local:443",
 				},
 				{
 					Name:  "MINIO_UPDATE",
@@ -139,7 +139,7 @@ func TestGetTenantConfiguration(t *testing.T) {
 				},
 				{
 					Name:  "MINIO_SERVER_URL",
-					Value: "https://minio..svc.cluster.local:443",
+					Value: "https://..svc.cluster.local:443",
 				},
 				{
 					Name:  "MINIO_UPDATE",
@@ -278,7 +278,7 @@ export MINIO_BROWSER="on"
 export MINIO_PROMETHEUS_JOB_ID="minio-job"
 export MINIO_ROOT_PASSWORD="minio123"
 export MINIO_ROOT_USER="minio"
-export MINIO_SERVER_URL="https://minio..svc.cluster.local:443"
+export MINIO_SERVER_URL="https://..svc.cluster.local:443"
 export MINIO_STORAGE_CLASS_STANDARD="EC:2"
 export MINIO_UPDATE="on"
 export MINIO_UPDATE_MINISIGN_PUBKEY="RWTx5Zr1tiHQLwG9keckT0c45M3AGeHD6IvimQHpyRywVWGbP1aVSGav"
@@ -316,7 +316,7 @@ export MINIO_BROWSER_REDIRECT_URL="http://console.minio"
 export MINIO_PROMETHEUS_JOB_ID="minio-job"
 export MINIO_ROOT_PASSWORD="minio123"
 export MINIO_ROOT_USER="minio"
-export MINIO_SERVER_URL="https://minio..svc.cluster.local:443"
+export MINIO_SERVER_URL="https://..svc.cluster.local:443"
 export MINIO_STORAGE_CLASS_STANDARD="EC:2"
 export MINIO_UPDATE="on"
 export MINIO_UPDATE_MINISIGN_PUBKEY="RWTx5Zr1tiHQLwG9keckT0c45M3AGeHD6IvimQHpyRywVWGbP1aVSGav"
@@ -366,7 +366,7 @@ export MINIO_BROWSER_REDIRECT_URL="http://console.minio"
 export MINIO_PROMETHEUS_JOB_ID="minio-job"
 export MINIO_ROOT_PASSWORD="minio123"
 export MINIO_ROOT_USER="minio"
-export MINIO_SERVER_URL="https://minio.ns-x.svc.cluster.local:443"
+export MINIO_SERVER_URL="https://tenant.ns-x.svc.cluster.local:443"
 export MINIO_STORAGE_CLASS_STANDARD="EC:2"
 export MINIO_UPDATE="on"
 export MINIO_UPDATE_MINISIGN_PUBKEY="RWTx5Zr1tiHQLwG9keckT0c45M3AGeHD6IvimQHpyRywVWGbP1aVSGav"
@@ -0,0 +1,525 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/certs"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// accessKeyAPI is the part of the MinIO admin client used to sync an access key
+type accessKeyAPI interface {
+	AddServiceAccount(ctx context.Context, opts madmin.AddServiceAccountReq) (madmin.Credentials, error)
+	InfoServiceAccount(ctx context.Context, accessKey string) (madmin.InfoServiceAccountResp, error)
+	UpdateServiceAccount(ctx context.Context, accessKey string, opts madmin.UpdateServiceAccountReq) error
+	DeleteServiceAccount(ctx context.Context, serviceAccount string) error
+	ListServiceAccounts(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error)
+}
+
+// enqueueAccessKey takes a MinIOAccessKey resource and puts its namespace/name key onto the access key queue
+func (c *Controller) enqueueAccessKey(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	if !c.namespacesToWatch.IsEmpty() {
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			runtime.HandleError(err)
+			return
+		}
+		if !c.namespacesToWatch.Contains(object.GetNamespace()) {
+			klog.Infof("Ignoring access key `%s` in namespace that is not watched by this controller.", key)
+			return
+		}
+	}
+	c.accessKeyQueue.Add(key)
+}
+
+// runAccessKeyWorker processes the MinIOAccessKeys of the access key queue
+func (c *Controller) runAccessKeyWorker() {
+	defer runtime.HandleCrash()
+	for processNextItem(c.accessKeyQueue, c.syncAccessKeyHandler) {
+	}
+}
+
+// syncAccessKeyHandler creates the access key of a MinIOAccessKey resource in its tenant, writes it to its Secret and
+// rotates it
+func (c *Controller) syncAccessKeyHandler(key string) (Result, error) {
+	ctx := context.Background()
+	namespace, name := key2NamespaceName(key)
+	accessKey, err := c.accessKeyLister.MinIOAccessKeys(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, nil)
+		}
+		return WrapResult(Result{}, err)
+	}
+	accessKey = accessKey.DeepCopy()
+
+	tenant, err := c.minioClientSet.MinioV2().Tenants(namespace).Get(ctx, accessKey.Spec.Tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, err)
+		}
+		tenant = nil
+	}
+
+	if accessKey.DeletionTimestamp != nil {
+		return WrapResult(Result{}, c.finalizeAccessKey(ctx, key, accessKey, tenant))
+	}
+	if !controllerutil.ContainsFinalizer(accessKey, iamv1alpha1.IAMFinalizer) {
+		controllerutil.AddFinalizer(accessKey, iamv1alpha1.IAMFinalizer)
+		// the update queues the access key again
+		_, err = c.minioClientSet.IamV1alpha1().MinIOAccessKeys(namespace).Update(ctx, accessKey, metav1.UpdateOptions{})
+		return WrapResult(Result{}, err)
+	}
+
+	if err = accessKey.Validate(); err != nil {
+		return WrapResult(Result{}, c.updateAccessKeyReady(ctx, accessKey, metav1.ConditionFalse, "InvalidSpec", err.Error()))
+	}
+	if tenant == nil {
+		msg := fmt.Sprintf("Tenant %s not found", accessKey.Spec.Tenant.Name)
+		return WrapResult(Result{RequeueAfter: 30 * time.Second}, c.updateAccessKeyReady(ctx, accessKey, metav1.ConditionFalse, "TenantNotFound", msg))
+	}
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		msg := fmt.Sprintf("Waiting for tenant %s to be healthy", tenant.Name)
+		return WrapResult(Result{RequeueAfter: 10 * time.Second}, c.updateAccessKeyReady(ctx, accessKey, metav1.ConditionFalse, "TenantNotReady", msg))
+	}
+
+	adminClient, err := c.newTenantAdminClient(ctx, tenant)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+	secret, err := c.kubeClientSet.CoreV1().Secrets(namespace).Get(ctx, accessKey.SecretName(), metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, err)
+		}
+		secret = nil
+	}
+	caBundle, err := c.tenantCABundle(ctx, tenant)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+
+	now := time.Now()
+	creds, reason, err := syncAccessKey(ctx, adminClient, accessKey, secret, now)
+	if err == nil && creds != nil {
+		previous := accessKey.Status.Name
+		var recorded *iamv1alpha1.MinIOAccessKey
+		if recorded, err = c.recordAccessKey(ctx, accessKey, creds.AccessKey, reason, now); err != nil {
+			// nobody has the secret key of the new access key, don't leave it behind
+			if derr := adminClient.DeleteServiceAccount(ctx, creds.AccessKey); derr != nil {
+				klog.Errorf("'%s' Failed to delete access key %s: %v", key, creds.AccessKey, derr)
+			}
+		} else {
+			accessKey = recorded
+			switch {
+			case previous == "":
+				c.recorder.Event(accessKey, corev1.EventTypeNormal, "AccessKeyCreated", fmt.Sprintf("Created access key %s", creds.AccessKey))
+			case reason == accessKeyMissing:
+				msg := fmt.Sprintf("Replaced access key %s, it was deleted outside of the MinIOAccessKey resource", previous)
+				klog.Infof("'%s' %s", key, msg)
+				c.recorder.Event(accessKey, corev1.EventTypeWarning, "AccessKeyDrift", msg)
+			default:
+				c.recorder.Event(accessKey, corev1.EventTypeNormal, "AccessKeyRotated", fmt.Sprintf("Replaced access key %s by %s: %s", previous, creds.AccessKey, reason))
+			}
+		}
+	}
+	if err == nil {
+		// an access key recorded in the status but missing from the Secret is replaced on the next sync
+		err = c.writeAccessKeySecret(ctx, accessKey, secret, creds, tenant.MinIOServerEndpoint(), caBundle)
+	}
+	if err != nil {
+		klog.Errorf("'%s' Failed to sync access key: %v", key, err)
+		c.recorder.Event(accessKey, corev1.EventTypeWarning, "AccessKeySyncFailed", err.Error())
+		if uerr := c.updateAccessKeyReady(ctx, accessKey, metav1.ConditionFalse, "SyncFailed", err.Error()); uerr != nil {
+			return WrapResult(Result{}, uerr)
+		}
+		return WrapResult(Result{}, err)
+	}
+	if creds == nil && reason != "" {
+		c.recorder.Event(accessKey, corev1.EventTypeNormal, "AccessKeyUpdated", fmt.Sprintf("Updated access key %s: %s", accessKey.Status.Name, reason))
+	}
+
+	// the access keys are only deleted once the Secret holds the current one
+	if err = deleteRetiredAccessKeys(ctx, adminClient, &accessKey.Status, now); err == nil {
+		err = deleteUntrackedAccessKeys(ctx, adminClient, accessKey)
+	}
+	if err != nil {
+		klog.Errorf("'%s' Failed to delete access keys: %v", key, err)
+		c.recorder.Event(accessKey, corev1.EventTypeWarning, "AccessKeySyncFailed", err.Error())
+	}
+	accessKey.Status.ObservedGeneration = accessKey.Generation
+	if err = c.updateAccessKeyReady(ctx, accessKey, metav1.ConditionTrue, "AccessKeySynced", "The access key matches its spec"); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	return WrapResult(Result{RequeueAfter: nextAccessKeySync(accessKey, now)}, nil)
+}
+
+// recordAccessKey makes the new access key the current one and persists the status before the access key is handed
+// out or the previous one is deleted, so an access key is never left behind untracked
+func (c *Controller) recordAccessKey(ctx context.Context, accessKey *iamv1alpha1.MinIOAccessKey, name, reason string, now time.Time) (*iamv1alpha1.MinIOAccessKey, error) {
+	updated := accessKey.DeepCopy()
+	retireAccessKey(updated, name, now)
+	if reason == accessKeyMissing {
+		drift := metav1.NewTime(now)
+		updated.Status.LastDriftTime = &drift
+	}
+	return c.minioClientSet.IamV1alpha1().MinIOAccessKeys(accessKey.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+}
+
+// writeAccessKeySecret writes the credentials, endpoint and CA of the tenant to the Secret of the access key. creds
+// is nil to keep the credentials of the Secret.
+func (c *Controller) writeAccessKeySecret(ctx context.Context, accessKey *iamv1alpha1.MinIOAccessKey, secret *corev1.Secret, creds *madmin.Credentials, endpoint string, caBundle []byte) error {
+	data := map[string][]byte{}
+	if secret != nil {
+		for k, v := range secret.Data {
+			data[k] = v
+		}
+	}
+	if creds != nil {
+		data[iamv1alpha1.AccessKeyIDField] = []byte(creds.AccessKey)
+		data[iamv1alpha1.SecretAccessKeyField] = []byte(creds.SecretKey)
+	}
+	data[iamv1alpha1.EndpointField] = []byte(endpoint)
+	if len(caBundle) > 0 {
+		data[iamv1alpha1.CAField] = caBundle
+	} else {
+		delete(data, iamv1alpha1.CAField)
+	}
+
+	secrets := c.kubeClientSet.CoreV1().Secrets(accessKey.Namespace)
+	if secret == nil {
+		_, err := secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            accessKey.SecretName(),
+				Namespace:       accessKey.Namespace,
+				OwnerReferences: accessKey.OwnerRef(),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if equality.Semantic.DeepEqual(secret.Data, data) {
+		return nil
+	}
+	secret = secret.DeepCopy()
+	secret.Data = data
+	_, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// tenantCABundle returns the CA certificates the applications need to trust the tenant, nil if it doesn't use TLS
+func (c *Controller) tenantCABundle(ctx context.Context, tenant *miniov2.Tenant) ([]byte, error) {
+	if !tenant.TLS() {
+		return nil, nil
+	}
+	var bundle []byte
+	if tenant.AutoCert() {
+		// the certificates of AutoCert are signed by the Kubernetes CA
+		bundle = append(bundle, miniov2.GetPodCAFromFile()...)
+	}
+	for _, caSecret := range tenant.Spec.ExternalCaCertSecret {
+		secret, err := c.kubeClientSet.CoreV1().Secrets(tenant.Namespace).Get(ctx, caSecret.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range []string{certs.CAPublicCertFile, certs.PublicCertFile, certs.TLSCertFile} {
+			if cert, ok := secret.Data[field]; ok {
+				bundle = append(bundle, bytes.TrimSpace(cert)...)
+				bundle = append(bundle, '\n')
+				break
+			}
+		}
+	}
+	return bundle, nil
+}
+
+// finalizeAccessKey deletes the access keys of a deleted MinIOAccessKey from its tenant, its Secret is garbage
+// collected
+func (c *Controller) finalizeAccessKey(ctx context.Context, key string, accessKey *iamv1alpha1.MinIOAccessKey, tenant *miniov2.Tenant) error {
+	if !controllerutil.ContainsFinalizer(accessKey, iamv1alpha1.IAMFinalizer) {
+		return nil
+	}
+	// the access keys are gone along with a deleted tenant
+	if tenant != nil && tenant.DeletionTimestamp == nil {
+		adminClient, err := c.newTenantAdminClient(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		names := []string{accessKey.Status.Name}
+		for _, retired := range accessKey.Status.RetiredAccessKeys {
+			names = append(names, retired.Name)
+		}
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			if err = deleteAccessKey(ctx, adminClient, name); err != nil {
+				return err
+			}
+			klog.Infof("'%s' Deleted access key %s", key, name)
+		}
+	}
+	controllerutil.RemoveFinalizer(accessKey, iamv1alpha1.IAMFinalizer)
+	if _, err := c.minioClientSet.IamV1alpha1().MinIOAccessKeys(accessKey.Namespace).Update(ctx, accessKey, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// updateAccessKeyReady sets the Ready condition of the access key and persists its status if it changed
+func (c *Controller) updateAccessKeyReady(ctx context.Context, accessKey *iamv1alpha1.MinIOAccessKey, status metav1.ConditionStatus, reason, msg string) error {
+	current, err := c.accessKeyLister.MinIOAccessKeys(accessKey.Namespace).Get(accessKey.Name)
+	if err != nil {
+		return err
+	}
+	updated := accessKey.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+		Type:               iamv1alpha1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: accessKey.Generation,
+	})
+	if equality.Semantic.DeepEqual(current.Status, updated.Status) {
+		return nil
+	}
+	_, err = c.minioClientSet.IamV1alpha1().MinIOAccessKeys(accessKey.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// accessKeyMissing is the reason an access key deleted outside of the operator is replaced
+const accessKeyMissing = "the access key doesn't exist in MinIO"
+
+// syncAccessKey creates a new access key when the current one has to be replaced, or updates the current one to
+// match the spec. Returns the credentials of the new access key, if any, and why the access key was replaced or
+// updated.
+func syncAccessKey(ctx context.Context, api accessKeyAPI, accessKey *iamv1alpha1.MinIOAccessKey, secret *corev1.Secret, now time.Time) (*madmin.Credentials, string, error) {
+	policy, err := accessKey.ParsePolicy()
+	if err != nil {
+		return nil, "", err
+	}
+	var expiration *time.Time
+	if accessKey.Spec.Expiry != nil {
+		created := now
+		if accessKey.Status.CreationTime != nil {
+			created = accessKey.Status.CreationTime.Time
+		}
+		expiration = ptrTime(created.Add(accessKey.Spec.Expiry.Duration).UTC().Truncate(time.Second))
+	}
+
+	var info *madmin.InfoServiceAccountResp
+	if accessKey.Status.Name != "" {
+		resp, err := api.InfoServiceAccount(ctx, accessKey.Status.Name)
+		if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminServiceAccountNotFound" {
+			return nil, "", err
+		}
+		if err == nil {
+			info = &resp
+		}
+	}
+
+	reason := accessKeyReplacement(accessKey, info, secret, expiration, now)
+	if reason != "" {
+		req := madmin.AddServiceAccountReq{
+			TargetUser:  accessKey.Spec.User,
+			Description: accessKeyDescription(accessKey),
+		}
+		if policy != nil {
+			req.Policy = accessKey.Spec.Policy.Raw
+		}
+		if accessKey.Spec.Expiry != nil {
+			req.Expiration = ptrTime(now.Add(accessKey.Spec.Expiry.Duration).UTC().Truncate(time.Second))
+		}
+		creds, err := api.AddServiceAccount(ctx, req)
+		if err != nil {
+			return nil, "", err
+		}
+		return &creds, reason, nil
+	}
+
+	update := madmin.UpdateServiceAccountReq{}
+	if policy != nil {
+		current, err := iampolicy.ParseConfig(bytes.NewReader([]byte(info.Policy)))
+		if err != nil || info.ImpliedPolicy || !reflect.DeepEqual(current, policy) {
+			update.NewPolicy = accessKey.Spec.Policy.Raw
+			reason = "policy"
+		}
+	}
+	if expiration != nil && (!hasExpiration(info.Expiration) || !info.Expiration.Equal(*expiration)) {
+		update.NewExpiration = expiration
+		if reason != "" {
+			reason += ", "
+		}
+		reason += "expiry"
+	}
+	if reason == "" {
+		return nil, "", nil
+	}
+	if err = api.UpdateServiceAccount(ctx, accessKey.Status.Name, update); err != nil {
+		return nil, "", err
+	}
+	return nil, reason, nil
+}
+
+// accessKeyReplacement returns why the current access key has to be replaced by a new one, empty if it can be kept
+func accessKeyReplacement(accessKey *iamv1alpha1.MinIOAccessKey, info *madmin.InfoServiceAccountResp, secret *corev1.Secret, expiration *time.Time, now time.Time) string {
+	switch {
+	case accessKey.Status.Name == "":
+		return "new access key"
+	case info == nil:
+		return accessKeyMissing
+	case secret == nil || string(secret.Data[iamv1alpha1.AccessKeyIDField]) != accessKey.Status.Name:
+		// the secret key is only known when the access key is created
+		return "the Secret doesn't hold the access key"
+	case info.ParentUser != accessKey.Spec.User:
+		return "the user changed"
+	case accessKey.Spec.Policy == nil && !info.ImpliedPolicy:
+		// MinIO can't remove the policy of an access key
+		return "the policy was removed"
+	case expiration == nil && hasExpiration(info.Expiration):
+		// MinIO can't remove the expiration of an access key
+		return "the expiry was removed"
+	case accessKey.Spec.Rotation != nil && accessKey.Status.CreationTime != nil &&
+		!now.Before(accessKey.Status.CreationTime.Add(accessKey.Spec.Rotation.Period.Duration)):
+		return "scheduled rotation"
+	}
+	return ""
+}
+
+// retireAccessKey makes the new access key the current one, the previous one is kept for the grace period of the
+// rotation
+func retireAccessKey(accessKey *iamv1alpha1.MinIOAccessKey, name string, now time.Time) {
+	status := &accessKey.Status
+	if status.Name != "" {
+		deletion := now
+		if rotation := accessKey.Spec.Rotation; rotation != nil && rotation.GracePeriod != nil {
+			deletion = now.Add(rotation.GracePeriod.Duration)
+		}
+		status.RetiredAccessKeys = append(status.RetiredAccessKeys, iamv1alpha1.RetiredAccessKey{
+			Name:         status.Name,
+			DeletionTime: metav1.NewTime(deletion),
+		})
+	}
+	created := metav1.NewTime(now)
+	status.Name = name
+	status.CreationTime = &created
+}
+
+// deleteRetiredAccessKeys deletes the retired access keys at the end of their grace period
+func deleteRetiredAccessKeys(ctx context.Context, api accessKeyAPI, status *iamv1alpha1.MinIOAccessKeyStatus, now time.Time) error {
+	var kept []iamv1alpha1.RetiredAccessKey
+	for i, retired := range status.RetiredAccessKeys {
+		if now.Before(retired.DeletionTime.Time) {
+			kept = append(kept, retired)
+			continue
+		}
+		if err := deleteAccessKey(ctx, api, retired.Name); err != nil {
+			status.RetiredAccessKeys = append(kept, status.RetiredAccessKeys[i:]...)
+			return err
+		}
+	}
+	status.RetiredAccessKeys = kept
+	return nil
+}
+
+// deleteUntrackedAccessKeys deletes the access keys of the user created for the MinIOAccessKey that its status doesn't
+// know about, such as one created right before the operator stopped
+func deleteUntrackedAccessKeys(ctx context.Context, api accessKeyAPI, accessKey *iamv1alpha1.MinIOAccessKey) error {
+	resp, err := api.ListServiceAccounts(ctx, accessKey.Spec.User)
+	if err != nil {
+		return err
+	}
+	tracked := map[string]bool{accessKey.Status.Name: true}
+	for _, retired := range accessKey.Status.RetiredAccessKeys {
+		tracked[retired.Name] = true
+	}
+	description := accessKeyDescription(accessKey)
+	for _, account := range resp.Accounts {
+		if account.Description != description || tracked[account.AccessKey] {
+			continue
+		}
+		if err = deleteAccessKey(ctx, api, account.AccessKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accessKeyDescription is the description of the access keys created for the MinIOAccessKey
+func accessKeyDescription(accessKey *iamv1alpha1.MinIOAccessKey) string {
+	return fmt.Sprintf("Managed by the MinIOAccessKey %s/%s", accessKey.Namespace, accessKey.Name)
+}
+
+// deleteAccessKey deletes the access key from MinIO, an access key that is already gone isn't an error
+func deleteAccessKey(ctx context.Context, api accessKeyAPI, name string) error {
+	err := api.DeleteServiceAccount(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminServiceAccountNotFound" {
+		return err
+	}
+	return nil
+}
+
+// nextAccessKeySync returns when the access key has to be synced again: at its next rotation, at the end of the grace
+// period of a retired access key, and at least every iamResyncPeriod
+func nextAccessKeySync(accessKey *iamv1alpha1.MinIOAccessKey, now time.Time) time.Duration {
+	next := iamResyncPeriod
+	if rotation := accessKey.Spec.Rotation; rotation != nil && accessKey.Status.CreationTime != nil {
+		if d := accessKey.Status.CreationTime.Add(rotation.Period.Duration).Sub(now); d < next {
+			next = d
+		}
+	}
+	for _, retired := range accessKey.Status.RetiredAccessKeys {
+		if d := retired.DeletionTime.Sub(now); d < next {
+			next = d
+		}
+	}
+	if next < time.Second {
+		next = time.Second
+	}
+	return next
+}
+
+// hasExpiration returns true if MinIO returned an expiration, access keys without one have the zero Unix time
+func hasExpiration(expiration *time.Time) bool {
+	return expiration != nil && expiration.Unix() > 0
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// fakeAccessKeyAPI keeps the access keys in memory
+type fakeAccessKeyAPI struct {
+	keys    map[string]madmin.InfoServiceAccountResp
+	created int
+	updated int
+}
+
+func (f *fakeAccessKeyAPI) AddServiceAccount(_ context.Context, opts madmin.AddServiceAccountReq) (madmin.Credentials, error) {
+	f.created++
+	accessKey := fmt.Sprintf("key%d", f.created)
+	f.keys[accessKey] = madmin.InfoServiceAccountResp{
+		ParentUser:    opts.TargetUser,
+		Description:   opts.Description,
+		ImpliedPolicy: len(opts.Policy) == 0,
+		Policy:        string(opts.Policy),
+		Expiration:    opts.Expiration,
+	}
+	return madmin.Credentials{AccessKey: accessKey, SecretKey: "secret-" + accessKey}, nil
+}
+
+func (f *fakeAccessKeyAPI) InfoServiceAccount(_ context.Context, accessKey string) (madmin.InfoServiceAccountResp, error) {
+	info, ok := f.keys[accessKey]
+	if !ok {
+		return info, madmin.ErrorResponse{Code: "XMinioAdminServiceAccountNotFound"}
+	}
+	return info, nil
+}
+
+func (f *fakeAccessKeyAPI) UpdateServiceAccount(_ context.Context, accessKey string, opts madmin.UpdateServiceAccountReq) error {
+	f.updated++
+	info := f.keys[accessKey]
+	if len(opts.NewPolicy) > 0 {
+		info.Policy, info.ImpliedPolicy = string(opts.NewPolicy), false
+	}
+	if opts.NewExpiration != nil {
+		info.Expiration = opts.NewExpiration
+	}
+	f.keys[accessKey] = info
+	return nil
+}
+
+func (f *fakeAccessKeyAPI) DeleteServiceAccount(_ context.Context, accessKey string) error {
+	if _, ok := f.keys[accessKey]; !ok {
+		return madmin.ErrorResponse{Code: "XMinioAdminServiceAccountNotFound"}
+	}
+	delete(f.keys, accessKey)
+	return nil
+}
+
+func (f *fakeAccessKeyAPI) ListServiceAccounts(_ context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+	var resp madmin.ListServiceAccountsResp
+	for accessKey, info := range f.keys {
+		if info.ParentUser == user {
+			resp.Accounts = append(resp.Accounts, madmin.ServiceAccountInfo{
+				ParentUser:  info.ParentUser,
+				AccessKey:   accessKey,
+				Description: info.Description,
+			})
+		}
+	}
+	return resp, nil
+}
+
+func TestSyncAccessKey(t *testing.T) {
+	accessKey := &iamv1alpha1.MinIOAccessKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "tenant-ns"},
+		Spec: iamv1alpha1.MinIOAccessKeySpec{
+			Tenant: iamv1alpha1.TenantReference{Name: "myminio"},
+			User:   "app-user",
+			Rotation: &iamv1alpha1.AccessKeyRotation{
+				Period:      metav1.Duration{Duration: 24 * time.Hour},
+				GracePeriod: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+	api := &fakeAccessKeyAPI{keys: map[string]madmin.InfoServiceAccountResp{}}
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	// sync runs a sync at the time and writes the new access key to the secret like the controller does
+	var secret *corev1.Secret
+	sync := func(at time.Time) (*madmin.Credentials, string) {
+		creds, reason, err := syncAccessKey(ctx, api, accessKey, secret, at)
+		if err != nil {
+			t.Fatalf("syncAccessKey() error = %v", err)
+		}
+		if creds != nil {
+			secret = &corev1.Secret{Data: map[string][]byte{iamv1alpha1.AccessKeyIDField: []byte(creds.AccessKey)}}
+			retireAccessKey(accessKey, creds.AccessKey, at)
+		}
+		if err = deleteRetiredAccessKeys(ctx, api, &accessKey.Status, at); err != nil {
+			t.Fatalf("deleteRetiredAccessKeys() error = %v", err)
+		}
+		return creds, reason
+	}
+
+	if creds, _ := sync(now); creds == nil || accessKey.Status.Name != "key1" || api.keys["key1"].ParentUser != "app-user" {
+		t.Fatalf("syncAccessKey() didn't create the access key: %+v", accessKey.Status)
+	}
+	if creds, reason := sync(now.Add(time.Minute)); creds != nil || reason != "" {
+		t.Errorf("syncAccessKey() of an access key in sync = %v, %s", creds, reason)
+	}
+	if got := nextAccessKeySync(accessKey, now.Add(time.Minute)); got != iamResyncPeriod {
+		t.Errorf("nextAccessKeySync() = %v, want %v", got, iamResyncPeriod)
+	}
+
+	// a policy is applied to the current access key
+	accessKey.Spec.Policy = &runtime.RawExtension{Raw: []byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`)}
+	if creds, reason := sync(now.Add(2 * time.Minute)); creds != nil || reason != "policy" || api.keys["key1"].ImpliedPolicy {
+		t.Errorf("syncAccessKey() of a new policy = %v, %s", creds, reason)
+	}
+	if creds, reason := sync(now.Add(3 * time.Minute)); creds != nil || reason != "" {
+		t.Errorf("syncAccessKey() after the policy update = %v, %s", creds, reason)
+	}
+
+	// the rotation keeps the previous access key for the grace period
+	rotation := now.Add(24 * time.Hour)
+	if creds, reason := sync(rotation); creds == nil || reason != "scheduled rotation" {
+		t.Fatalf("syncAccessKey() at the rotation = %v, %s", creds, reason)
+	}
+	if _, ok := api.keys["key1"]; !ok || accessKey.Status.Name != "key2" || len(accessKey.Status.RetiredAccessKeys) != 1 {
+		t.Fatalf("syncAccessKey() didn't retire the access key: %+v", accessKey.Status)
+	}
+	if got := nextAccessKeySync(accessKey, rotation); got != iamResyncPeriod {
+		t.Errorf("nextAccessKeySync() = %v, want %v", got, iamResyncPeriod)
+	}
+	if got := nextAccessKeySync(accessKey, rotation.Add(58*time.Minute)); got != 2*time.Minute {
+		t.Errorf("nextAccessKeySync() = %v, want %v", got, 2*time.Minute)
+	}
+	sync(rotation.Add(time.Hour))
+	if _, ok := api.keys["key1"]; ok || len(accessKey.Status.RetiredAccessKeys) != 0 {
+		t.Errorf("deleteRetiredAccessKeys() didn't delete the retired access key: %+v", accessKey.Status)
+	}
+
+	// an access key deleted outside of the MinIOAccessKey resource is replaced
+	delete(api.keys, "key2")
+	if creds, reason := sync(rotation.Add(2 * time.Hour)); creds == nil || reason != accessKeyMissing {
+		t.Errorf("syncAccessKey() of a missing access key = %v, %s", creds, reason)
+	}
+
+	// the secret key is lost with the Secret
+	secret = nil
+	if creds, _ := sync(rotation.Add(3 * time.Hour)); creds == nil {
+		t.Errorf("syncAccessKey() without its Secret didn't replace the access key")
+	}
+}
+
+func TestDeleteUntrackedAccessKeys(t *testing.T) {
+	accessKey := &iamv1alpha1.MinIOAccessKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "tenant-ns"},
+		Spec: iamv1alpha1.MinIOAccessKeySpec{
+			Tenant: iamv1alpha1.TenantReference{Name: "myminio"},
+			User:   "app-user",
+		},
+		Status: iamv1alpha1.MinIOAccessKeyStatus{
+			RetiredAccessKeys: []iamv1alpha1.RetiredAccessKey{{Name: "retired"}},
+		},
+	}
+	accessKey.Status.Name = "current"
+	managed := accessKeyDescription(accessKey)
+	api := &fakeAccessKeyAPI{keys: map[string]madmin.InfoServiceAccountResp{
+		"current":   {ParentUser: "app-user", Description: managed},
+		"retired":   {ParentUser: "app-user", Description: managed},
+		"untracked": {ParentUser: "app-user", Description: managed},
+		"manual":    {ParentUser: "app-user", Description: "created by hand"},
+		"other":     {ParentUser: "app-user", Description: "Managed by the MinIOAccessKey tenant-ns/other"},
+	}}
+	if err := deleteUntrackedAccessKeys(context.Background(), api, accessKey); err != nil {
+		t.Fatalf("deleteUntrackedAccessKeys() error = %v", err)
+	}
+	if _, ok := api.keys["untracked"]; ok {
+		t.Errorf("deleteUntrackedAccessKeys() kept the untracked access key")
+	}
+	for _, name := range []string{"current", "retired", "manual", "other"} {
+		if _, ok := api.keys[name]; !ok {
+			t.Errorf("deleteUntrackedAccessKeys() deleted the access key %s", name)
+		}
+	}
+}
@@ -0,0 +1,473 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/certs"
+	"github.com/minio/operator/pkg/common"
+	xcerts "github.com/minio/pkg/certs"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// AdmissionEnabled Env variable name to turn on and off the Tenant admission webhooks, enabled by default
+	AdmissionEnabled = "OPERATOR_ADMISSION_ENABLED"
+
+	// AdmissionServiceName is the name of the service routing the admission requests to the Operator leader
+	AdmissionServiceName = "operator"
+
+	// AdmissionTLSSecretName is the name of secret created for the Operator admission webhook TLS certs
+	AdmissionTLSSecretName = "operator-tls"
+
+	// TenantValidatingWebhookName is the name of the ValidatingWebhookConfiguration registered by the Operator
+	TenantValidatingWebhookName = "tenant-validation.minio.min.io"
+
+	// TenantDefaultingWebhookName is the name of the MutatingWebhookConfiguration registered by the Operator
+	TenantDefaultingWebhookName = "tenant-defaulting.minio.min.io"
+)
+
+// IsAdmissionEnabled Validates if the admission webhooks are turned on, they are enabled by default
+func IsAdmissionEnabled() bool {
+	value, set := os.LookupEnv(AdmissionEnabled)
+	if set {
+		return value == "on"
+	}
+	return true
+}
+
+// configureAdmissionServer configures the https server receiving AdmissionReview requests from the kube-apiserver
+func configureAdmissionServer(c *Controller) *http.Server {
+	router := mux.NewRouter().SkipClean(true).UseEncodedPath()
+
+	router.Methods(http.MethodPost).
+		Path(common.AdmissionAPIValidateTenant).
+		HandlerFunc(c.ValidateTenantHandler)
+
+	router.Methods(http.MethodPost).
+		Path(common.AdmissionAPIDefaultTenant).
+		HandlerFunc(c.DefaultTenantHandler)
+
+	router.NotFoundHandler = http.NotFoundHandler()
+
+	s := &http.Server{
+		Addr:           ":" + common.AdmissionServerPort,
+		Handler:        router,
+		ReadTimeout:    time.Minute,
+		WriteTimeout:   time.Minute,
+		MaxHeaderBytes: 1 << 20,
+	}
+
+	return s
+}
+
+// startAdmissionServer Starts the admission webhook server and notifies the stop via notificationChannel
+func (c *Controller) startAdmissionServer(ctx context.Context, notificationChannel chan<- *EventNotification) {
+	klog.Infof("Starting Admission webhook server")
+
+	publicCertPath, privateKeyPath := c.waitAdmissionTLSCert()
+	certsManager, err := xcerts.NewManager(ctx, publicCertPath, privateKeyPath, LoadX509KeyPair)
+	if err != nil {
+		klog.Errorf("HTTPS Admission webhook server failed to load certificate: %v", err)
+		notificationChannel <- &EventNotification{
+			Type: AdmissionServerNotification,
+			Err:  err,
+		}
+		return
+	}
+	c.admission.TLSConfig = c.createTLSConfig(certsManager)
+
+	if err := c.admission.ListenAndServeTLS("", ""); !errors.Is(err, http.ErrServerClosed) {
+		// only notify on server failure, on http.ErrServerClosed the channel should be already closed
+		notificationChannel <- &EventNotification{
+			Type: AdmissionServerNotification,
+			Err:  err,
+		}
+	}
+}
+
+// generateAdmissionTLSCert Issues the Operator admission webhook TLS Certificate
+func (c *Controller) generateAdmissionTLSCert() (*string, *string) {
+	return c.generateTLSCertificateForService(AdmissionServiceName, AdmissionTLSSecretName, getOperatorDeploymentName())
+}
+
+// waitAdmissionTLSCert Waits for the Operator leader to issue the TLS Certificate for the admission webhooks
+func (c *Controller) waitAdmissionTLSCert() (string, string) {
+	return c.waitForCertSecretReady(AdmissionServiceName, AdmissionTLSSecretName)
+}
+
+// getAdmissionCABundle returns the CA the kube-apiserver uses to trust the admission webhook server. When the
+// certificate is provided externally it's expected to carry its CA in the secret, otherwise it has been issued
+// by the cluster signer.
+func (c *Controller) getAdmissionCABundle(ctx context.Context) ([]byte, error) {
+	secret, err := c.getCertificateSecret(ctx, miniov2.GetNSFromFile(), AdmissionTLSSecretName)
+	if err != nil {
+		return nil, err
+	}
+	if caBundle, ok := secret.Data[certs.CAPublicCertFile]; ok && len(caBundle) > 0 {
+		return caBundle, nil
+	}
+	caBundle := miniov2.GetPodCAFromFile()
+	if len(caBundle) == 0 {
+		return nil, errors.New("unable to determine the CA bundle for the admission webhooks")
+	}
+	return caBundle, nil
+}
+
+// registerAdmissionWebhooks creates or updates the webhook configurations pointing the kube-apiserver to the Operator
+func (c *Controller) registerAdmissionWebhooks(ctx context.Context) error {
+	caBundle, err := c.getAdmissionCABundle(ctx)
+	if err != nil {
+		return err
+	}
+	if err = c.registerValidatingWebhook(ctx, caBundle); err != nil {
+		return err
+	}
+	return c.registerMutatingWebhook(ctx, caBundle)
+}
+
+// registerValidatingWebhook creates or updates the ValidatingWebhookConfiguration for Tenants
+func (c *Controller) registerValidatingWebhook(ctx context.Context, caBundle []byte) error {
+	clientConfig, err := admissionClientConfig(common.AdmissionAPIValidateTenant, caBundle)
+	if err != nil {
+		return err
+	}
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	failurePolicy := admissionregistrationv1.Ignore
+	timeout := int32(10)
+
+	webhookConfiguration := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: admissionWebhookMeta(TenantValidatingWebhookName),
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name:                    TenantValidatingWebhookName,
+				ClientConfig:            clientConfig,
+				Rules:                   admissionTenantRules(admissionregistrationv1.Create, admissionregistrationv1.Update),
+				NamespaceSelector:       c.admissionNamespaceSelector(),
+				FailurePolicy:           &failurePolicy,
+				SideEffects:             &sideEffects,
+				TimeoutSeconds:          &timeout,
+				AdmissionReviewVersions: []string{admissionv1.SchemeGroupVersion.Version},
+			},
+		},
+	}
+
+	client := c.kubeClientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	existing, err := client.Get(ctx, webhookConfiguration.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("Registering validating webhook %s", webhookConfiguration.Name)
+		_, err = client.Create(ctx, webhookConfiguration, metav1.CreateOptions{})
+		return err
+	}
+	existing.Labels = webhookConfiguration.Labels
+	existing.Webhooks = webhookConfiguration.Webhooks
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// registerMutatingWebhook creates or updates the MutatingWebhookConfiguration for Tenants
+func (c *Controller) registerMutatingWebhook(ctx context.Context, caBundle []byte) error {
+	clientConfig, err := admissionClientConfig(common.AdmissionAPIDefaultTenant, caBundle)
+	if err != nil {
+		return err
+	}
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	failurePolicy := admissionregistrationv1.Ignore
+	reinvocationPolicy := admissionregistrationv1.NeverReinvocationPolicy
+	timeout := int32(10)
+
+	webhookConfiguration := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: admissionWebhookMeta(TenantDefaultingWebhookName),
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:                    TenantDefaultingWebhookName,
+				ClientConfig:            clientConfig,
+				Rules:                   admissionTenantRules(admissionregistrationv1.Create),
+				NamespaceSelector:       c.admissionNamespaceSelector(),
+				FailurePolicy:           &failurePolicy,
+				SideEffects:             &sideEffects,
+				ReinvocationPolicy:      &reinvocationPolicy,
+				TimeoutSeconds:          &timeout,
+				AdmissionReviewVersions: []string{admissionv1.SchemeGroupVersion.Version},
+			},
+		},
+	}
+
+	client := c.kubeClientSet.AdmissionregistrationV1().MutatingWebhookConfigurations()
+	existing, err := client.Get(ctx, webhookConfiguration.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("Registering mutating webhook %s", webhookConfiguration.Name)
+		_, err = client.Create(ctx, webhookConfiguration, metav1.CreateOptions{})
+		return err
+	}
+	existing.Labels = webhookConfiguration.Labels
+	existing.Webhooks = webhookConfiguration.Webhooks
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// admissionWebhookMeta returns the metadata for the webhook configurations registered by the Operator
+func admissionWebhookMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name: name,
+		Labels: map[string]string{
+			"app.kubernetes.io/name": "operator",
+		},
+	}
+}
+
+// admissionClientConfig points a webhook to the path of the Operator admission server
+func admissionClientConfig(path string, caBundle []byte) (admissionregistrationv1.WebhookClientConfig, error) {
+	port, err := strconv.ParseInt(common.AdmissionServerPort, 10, 32)
+	if err != nil {
+		return admissionregistrationv1.WebhookClientConfig{}, err
+	}
+	servicePort := int32(port)
+	return admissionregistrationv1.WebhookClientConfig{
+		Service: &admissionregistrationv1.ServiceReference{
+			Namespace: miniov2.GetNSFromFile(),
+			Name:      AdmissionServiceName,
+			Path:      &path,
+			Port:      &servicePort,
+		},
+		CABundle: caBundle,
+	}, nil
+}
+
+// admissionTenantRules matches the provided operations over Tenants
+func admissionTenantRules(operations ...admissionregistrationv1.OperationType) []admissionregistrationv1.RuleWithOperations {
+	return []admissionregistrationv1.RuleWithOperations{
+		{
+			Operations: operations,
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{miniov2.SchemeGroupVersion.Group},
+				APIVersions: []string{miniov2.SchemeGroupVersion.Version},
+				Resources:   []string{"tenants"},
+			},
+		},
+	}
+}
+
+// admissionNamespaceSelector limits the admission webhooks to the namespaces watched by the Operator
+func (c *Controller) admissionNamespaceSelector() *metav1.LabelSelector {
+	if len(c.namespacesToWatch) == 0 {
+		return &metav1.LabelSelector{}
+	}
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   c.namespacesToWatch.ToSlice(),
+			},
+		},
+	}
+}
+
+// ValidateTenantHandler validates Tenant objects on creation and update
+func (c *Controller) ValidateTenantHandler(w http.ResponseWriter, r *http.Request) {
+	review, err := readAdmissionReview(r)
+	if err != nil {
+		klog.Errorf("Error reading admission review: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// the services of a tenant are named after it, so it's validated against the other tenants of the namespace
+	tenants, err := c.minioClientSet.MinioV2().Tenants(review.Request.Namespace).List(r.Context(), metav1.ListOptions{})
+	if err != nil {
+		review.Response = admissionDenied(review.Request, fmt.Errorf("unable to list the tenants of the namespace: %w", err))
+	} else {
+		review.Response = validateTenantAdmission(review.Request, tenants.Items)
+	}
+	writeAdmissionReview(w, review)
+}
+
+// validateTenantAdmission runs the Tenant validations over the object in the admission request, its services must
+// not collide with the ones of the other tenants in the namespace
+func validateTenantAdmission(req *admissionv1.AdmissionRequest, tenants []miniov2.Tenant) *admissionv1.AdmissionResponse {
+	tenant := &miniov2.Tenant{}
+	if err := json.Unmarshal(req.Object.Raw, tenant); err != nil {
+		return admissionDenied(req, fmt.Errorf("unable to decode tenant: %w", err))
+	}
+	allowed := &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+	// a tenant being deleted must be able to lose its finalizers, whatever its spec
+	if tenant.DeletionTimestamp != nil {
+		return allowed
+	}
+	var oldTenant *miniov2.Tenant
+	if req.Operation == admissionv1.Update {
+		oldTenant = &miniov2.Tenant{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldTenant); err != nil {
+			return admissionDenied(req, fmt.Errorf("unable to decode tenant: %w", err))
+		}
+		// updates that leave the spec alone, such as the finalizers or labels, don't make the tenant any less valid
+		if equality.Semantic.DeepEqual(tenant.Spec, oldTenant.Spec) &&
+			tenant.Annotations[miniov2.MinIOServiceNameAnnotation] == oldTenant.Annotations[miniov2.MinIOServiceNameAnnotation] {
+			return allowed
+		}
+	}
+	// validate the tenant the same way it's validated during the reconciliation
+	if err := tenant.DeepCopy().EnsureDefaults().Validate(); err != nil {
+		return admissionDenied(req, err)
+	}
+	if oldTenant != nil {
+		if err := tenant.ValidateUpdate(oldTenant); err != nil {
+			return admissionDenied(req, err)
+		}
+	}
+	if err := tenant.ValidateServiceNames(tenants); err != nil {
+		return admissionDenied(req, err)
+	}
+	return allowed
+}
+
+// DefaultTenantHandler persists the Tenant defaults on creation
+func (c *Controller) DefaultTenantHandler(w http.ResponseWriter, r *http.Request) {
+	review, err := readAdmissionReview(r)
+	if err != nil {
+		klog.Errorf("Error reading admission review: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review.Response = defaultTenantAdmission(review.Request)
+	writeAdmissionReview(w, review)
+}
+
+// jsonPatchOperation is a single RFC 6902 operation returned to the kube-apiserver
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// defaultTenantAdmission patches the Tenant in the admission request with the values applied by EnsureDefaults,
+// so the stored object reflects what is deployed. Values derived from other fields, such as the certificate
+// configuration, are left out so they keep following the spec.
+func defaultTenantAdmission(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	tenant := &miniov2.Tenant{}
+	if err := json.Unmarshal(req.Object.Raw, tenant); err != nil {
+		return admissionDenied(req, fmt.Errorf("unable to decode tenant: %w", err))
+	}
+	defaulted := tenant.DeepCopy().EnsureDefaults()
+
+	var patch []jsonPatchOperation
+	addIfDefaulted := func(path string, current, value interface{}, isSet bool) {
+		if !isSet && !reflect.DeepEqual(current, value) {
+			patch = append(patch, jsonPatchOperation{Op: "add", Path: path, Value: value})
+		}
+	}
+	addIfDefaulted("/spec/image", tenant.Spec.Image, defaulted.Spec.Image, tenant.Spec.Image != "")
+	addIfDefaulted("/spec/imagePullPolicy", tenant.Spec.ImagePullPolicy, defaulted.Spec.ImagePullPolicy, tenant.Spec.ImagePullPolicy != "")
+	addIfDefaulted("/spec/podManagementPolicy", tenant.Spec.PodManagementPolicy, defaulted.Spec.PodManagementPolicy, tenant.Spec.PodManagementPolicy != "")
+	addIfDefaulted("/spec/mountPath", tenant.Spec.Mountpath, defaulted.Spec.Mountpath, tenant.Spec.Mountpath != "")
+	addIfDefaulted("/spec/subPath", tenant.Spec.Subpath, defaulted.Spec.Subpath, tenant.Spec.Subpath != "")
+	for pi, pool := range tenant.Spec.Pools {
+		addIfDefaulted(fmt.Sprintf("/spec/pools/%d/name", pi), pool.Name, defaulted.Spec.Pools[pi].Name, pool.Name != "")
+	}
+	if tenant.HasKESEnabled() {
+		kes := tenant.Spec.KES
+		addIfDefaulted("/spec/kes/image", kes.Image, defaulted.Spec.KES.Image, kes.Image != "")
+		addIfDefaulted("/spec/kes/replicas", kes.Replicas, defaulted.Spec.KES.Replicas, kes.Replicas != 0)
+		addIfDefaulted("/spec/kes/imagePullPolicy", kes.ImagePullPolicy, defaulted.Spec.KES.ImagePullPolicy, kes.ImagePullPolicy != "")
+		addIfDefaulted("/spec/kes/keyName", kes.KeyName, defaulted.Spec.KES.KeyName, kes.KeyName != "")
+	}
+
+	response := &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+	if len(patch) == 0 {
+		return response
+	}
+	payload, err := json.Marshal(patch)
+	if err != nil {
+		return admissionDenied(req, err)
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	response.Patch = payload
+	response.PatchType = &patchType
+	return response
+}
+
+// admissionDenied builds an AdmissionResponse rejecting the request with the provided error
+func admissionDenied(req *admissionv1.AdmissionRequest, err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+			Reason:  metav1.StatusReasonInvalid,
+			Code:    http.StatusUnprocessableEntity,
+		},
+	}
+}
+
+// readAdmissionReview decodes the AdmissionReview sent by the kube-apiserver
+func readAdmissionReview(r *http.Request) (*admissionv1.AdmissionReview, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 3<<20))
+	if err != nil {
+		return nil, err
+	}
+	review := &admissionv1.AdmissionReview{}
+	if err = json.Unmarshal(body, review); err != nil {
+		return nil, err
+	}
+	if review.Request == nil {
+		return nil, errors.New("admission review without request")
+	}
+	return review, nil
+}
+
+// writeAdmissionReview encodes the AdmissionReview response back to the kube-apiserver
+func writeAdmissionReview(w http.ResponseWriter, review *admissionv1.AdmissionReview) {
+	review.Request = nil
+	payload, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err = w.Write(payload); err != nil {
+		klog.Errorf("Error writing admission review response: %v", err)
+	}
+}
@@ -0,0 +1,246 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func admissionTestTenant(servers int32) *miniov2.Tenant {
+	return &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tenant",
+			Namespace: "tenant-ns",
+		},
+		Spec: miniov2.TenantSpec{
+			Configuration: &corev1.LocalObjectReference{Name: "tenant-env-configuration"},
+			Pools: []miniov2.Pool{
+				{
+					Name:             "pool-0",
+					Servers:          servers,
+					VolumesPerServer: 4,
+					VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+						Spec: corev1.PersistentVolumeClaimSpec{
+							AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: resource.MustParse("1Gi"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func admissionTestRaw(t *testing.T, tenant *miniov2.Tenant) runtime.RawExtension {
+	raw, err := json.Marshal(tenant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return runtime.RawExtension{Raw: raw}
+}
+
+func Test_validateTenantAdmission(t *testing.T) {
+	noConfiguration := admissionTestTenant(4)
+	noConfiguration.Spec.Configuration = nil
+
+	invalidFinalized := admissionTestTenant(0)
+	invalidFinalized.Finalizers = []string{miniov2.TenantFinalizer}
+
+	now := metav1.Now()
+	invalidDeleted := admissionTestTenant(0)
+	invalidDeleted.DeletionTimestamp = &now
+
+	// tenant "tenant-console" is named like the console service of tenant "tenant"
+	consoleNamed := admissionTestTenant(4)
+	consoleNamed.Name = "tenant-console"
+
+	// a new tenant "minio" is named like the service of a tenant created when only one was allowed per namespace
+	legacy := admissionTestTenant(4)
+	legacy.Annotations = map[string]string{miniov2.MinIOServiceNameAnnotation: miniov2.LegacyMinIOCIServiceName}
+	minioNamed := admissionTestTenant(4)
+	minioNamed.Name = miniov2.LegacyMinIOCIServiceName
+
+	invalidAnnotation := admissionTestTenant(4)
+	invalidAnnotation.Annotations = map[string]string{miniov2.MinIOServiceNameAnnotation: "Not_A_Service"}
+
+	collidingAnnotation := admissionTestTenant(4)
+	collidingAnnotation.Annotations = map[string]string{miniov2.MinIOServiceNameAnnotation: "other-hl"}
+	other := admissionTestTenant(4)
+	other.Name = "other"
+
+	tests := []struct {
+		name      string
+		operation admissionv1.Operation
+		tenant    *miniov2.Tenant
+		oldTenant *miniov2.Tenant
+		tenants   []miniov2.Tenant
+		allowed   bool
+	}{
+		{
+			name:      "Valid tenant creation",
+			operation: admissionv1.Create,
+			tenant:    admissionTestTenant(4),
+			allowed:   true,
+		},
+		{
+			name:      "Tenant without configuration",
+			operation: admissionv1.Create,
+			tenant:    noConfiguration,
+			allowed:   false,
+		},
+		{
+			name:      "Tenant with 0 servers",
+			operation: admissionv1.Create,
+			tenant:    admissionTestTenant(0),
+			allowed:   false,
+		},
+		{
+			name:      "Valid tenant update",
+			operation: admissionv1.Update,
+			tenant:    admissionTestTenant(4),
+			oldTenant: admissionTestTenant(4),
+			allowed:   true,
+		},
+		{
+			name:      "Changing pool servers",
+			operation: admissionv1.Update,
+			tenant:    admissionTestTenant(8),
+			oldTenant: admissionTestTenant(4),
+			allowed:   false,
+		},
+		{
+			name:      "Adding a finalizer to an invalid tenant",
+			operation: admissionv1.Update,
+			tenant:    invalidFinalized,
+			oldTenant: admissionTestTenant(0),
+			allowed:   true,
+		},
+		{
+			name:      "Removing the finalizer of a deleted invalid tenant",
+			operation: admissionv1.Update,
+			tenant:    invalidDeleted,
+			oldTenant: invalidDeleted,
+			allowed:   true,
+		},
+		{
+			name:      "Tenant named like the console service of another tenant",
+			operation: admissionv1.Create,
+			tenant:    consoleNamed,
+			tenants:   []miniov2.Tenant{*admissionTestTenant(4)},
+			allowed:   false,
+		},
+		{
+			name:      "Tenant named like the service of a legacy tenant",
+			operation: admissionv1.Create,
+			tenant:    minioNamed,
+			tenants:   []miniov2.Tenant{*legacy},
+			allowed:   false,
+		},
+		{
+			name:      "Updating a tenant listed in the namespace",
+			operation: admissionv1.Update,
+			tenant:    admissionTestTenant(4),
+			oldTenant: admissionTestTenant(4),
+			tenants:   []miniov2.Tenant{*admissionTestTenant(4), *other},
+			allowed:   true,
+		},
+		{
+			name:      "Service name annotation that isn't a service name",
+			operation: admissionv1.Create,
+			tenant:    invalidAnnotation,
+			allowed:   false,
+		},
+		{
+			name:      "Service name annotation set to the service of another tenant",
+			operation: admissionv1.Update,
+			tenant:    collidingAnnotation,
+			oldTenant: admissionTestTenant(4),
+			tenants:   []miniov2.Tenant{*admissionTestTenant(4), *other},
+			allowed:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{
+				UID:       "uid",
+				Operation: tt.operation,
+				Object:    admissionTestRaw(t, tt.tenant),
+			}
+			if tt.oldTenant != nil {
+				req.OldObject = admissionTestRaw(t, tt.oldTenant)
+			}
+			resp := validateTenantAdmission(req, tt.tenants)
+			if resp.UID != req.UID {
+				t.Errorf("validateTenantAdmission() UID = %v, want %v", resp.UID, req.UID)
+			}
+			if resp.Allowed != tt.allowed {
+				t.Errorf("validateTenantAdmission() Allowed = %v, want %v, result: %v", resp.Allowed, tt.allowed, resp.Result)
+			}
+		})
+	}
+}
+
+func Test_defaultTenantAdmission(t *testing.T) {
+	tenant := admissionTestTenant(4)
+	tenant.Spec.Pools[0].Name = ""
+	tenant.Spec.Image = "minio/minio:custom"
+
+	req := &admissionv1.AdmissionRequest{
+		UID:       "uid",
+		Operation: admissionv1.Create,
+		Object:    admissionTestRaw(t, tenant),
+	}
+	resp := defaultTenantAdmission(req)
+	if !resp.Allowed {
+		t.Fatalf("defaultTenantAdmission() denied the request: %v", resp.Result)
+	}
+	if resp.PatchType == nil || *resp.PatchType != admissionv1.PatchTypeJSONPatch {
+		t.Fatalf("defaultTenantAdmission() PatchType = %v, want %v", resp.PatchType, admissionv1.PatchTypeJSONPatch)
+	}
+	var patch []jsonPatchOperation
+	if err := json.Unmarshal(resp.Patch, &patch); err != nil {
+		t.Fatal(err)
+	}
+	patched := map[string]interface{}{}
+	for _, op := range patch {
+		patched[op.Path] = op.Value
+	}
+	want := map[string]interface{}{
+		"/spec/pools/0/name": miniov2.StatefulSetPrefix + "-0",
+		"/spec/mountPath":    miniov2.MinIOVolumeMountPath,
+	}
+	for path, value := range want {
+		if patched[path] != value {
+			t.Errorf("defaultTenantAdmission() %s = %v, want %v", path, patched[path], value)
+		}
+	}
+	if _, ok := patched["/spec/image"]; ok {
+		t.Errorf("defaultTenantAdmission() must not override the image set by the user")
+	}
+}
@@ -91,9 +91,24 @@ func (c *Controller) getKeychainForTenant(ctx context.Context, ref name.Referenc
 	}, nil
 }
 
+// tenantKeychain returns the keychain used to pull the images of the tenant
+func (c *Controller) tenantKeychain(ctx context.Context, ref name.Reference, tenant *miniov2.Tenant) authn.Keychain {
+	// if the tenant has imagePullSecret use that for pulling the image, but if we fail to extract the secret or we
+	// can't find the expected registry in the secret we will continue with the default keychain. This is because the
+	// needed pull secret could be attached to the service-account.
+	if tenant.Spec.ImagePullSecret.Name == "" {
+		return authn.DefaultKeychain
+	}
+	keychain, err := c.getKeychainForTenant(ctx, ref, tenant)
+	if err != nil {
+		klog.Info(err)
+	}
+	return keychain
+}
+
 // Attempts to fetch given image and then extracts and keeps relevant files
 // (minio, minio.sha256sum & minio.minisig) at a pre-defined location (/tmp/webhook/v1/update)
-func (c *Controller) fetchArtifacts(tenant *miniov2.Tenant) (latest string, err error) {
+func (c *Controller) fetchArtifacts(tenant *miniov2.Tenant, image string) (latest string, err error) {
 	c.removeArtifacts() // remove before a fresh fetch.
 
 	basePath := updatePath
@@ -102,26 +117,12 @@ func (c *Controller) fetchArtifacts(tenant *miniov2.Tenant) (latest string, err
 		return latest, err
 	}
 
-	ref, err := name.ParseReference(tenant.Spec.Image)
+	ref, err := name.ParseReference(image)
 	if err != nil {
 		return latest, err
 	}
 
-	var keychain authn.Keychain
-	keychain = authn.DefaultKeychain
-
-	// if the tenant has imagePullSecret use that for pulling the image, but if we fail to extract the secret or we
-	// can't find the expected registry in the secret we will continue with the default keychain. This is because the
-	// needed pull secret could be attached to the service-account.
-	if tenant.Spec.ImagePullSecret.Name != "" {
-		// Get the secret
-		keychain, err = c.getKeychainForTenant(context.Background(), ref, tenant)
-		if err != nil {
-			klog.Info(err)
-		}
-	}
-
-	img, err := remote.Image(ref, remote.WithAuthFromKeychain(keychain))
+	img, err := remote.Image(ref, remote.WithAuthFromKeychain(c.tenantKeychain(context.Background(), ref, tenant)))
 	if err != nil {
 		return latest, err
 	}
@@ -0,0 +1,204 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// checkAutoExpand adds a pool generated from the `spec.autoExpand` template to the spec of the tenant when its usage
+// crosses the threshold, or proposes it on an annotation when approval is required. The new pool is then created by
+// the next sync like any other pool added to the spec. Returns true if the spec of the tenant was updated.
+func (c *Controller) checkAutoExpand(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, bool, error) {
+	if !tenant.HasAutoExpand() {
+		return tenant, false, nil
+	}
+
+	if proposal, ok := tenant.Annotations[miniov2.AutoExpandProposalAnnotation]; ok {
+		var pool miniov2.Pool
+		if err := json.Unmarshal([]byte(proposal), &pool); err != nil {
+			return c.dropAutoExpandProposal(ctx, key, tenant, fmt.Sprintf("invalid %s annotation: %s", miniov2.AutoExpandProposalAnnotation, err))
+		}
+		// the tenant may have changed since the pool was proposed, it's only added if it would still be proposed
+		if reason := staleAutoExpandProposal(tenant, pool); reason != "" {
+			return c.dropAutoExpandProposal(ctx, key, tenant, reason)
+		}
+		approved := tenant.Annotations[miniov2.AutoExpandApproveAnnotation]
+		if approved == "" {
+			return tenant, false, nil
+		}
+		if approved != pool.Name {
+			klog.Infof("'%s' Approved pool %s doesn't match the proposed pool %s", key, approved, pool.Name)
+			return tenant, false, nil
+		}
+		return c.addAutoExpandPool(ctx, tenant, pool)
+	}
+
+	if !autoExpandNeeded(tenant) {
+		return tenant, false, nil
+	}
+	pool := autoExpandPool(tenant)
+	if err := pool.Validate(len(tenant.Spec.Pools)); err != nil {
+		c.recorder.Event(tenant, corev1.EventTypeWarning, "AutoExpandFailed", fmt.Sprintf("Invalid pool template: %s", err))
+		return tenant, false, nil
+	}
+
+	if !tenant.Spec.AutoExpand.RequireApproval {
+		return c.addAutoExpandPool(ctx, tenant, pool)
+	}
+	proposal, err := json.Marshal(pool)
+	if err != nil {
+		return tenant, false, err
+	}
+	// get the tenant as stored, the one being synced has the defaults applied
+	stored, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Get(ctx, tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		return tenant, false, err
+	}
+	if stored.Annotations == nil {
+		stored.Annotations = map[string]string{}
+	}
+	stored.Annotations[miniov2.AutoExpandProposalAnnotation] = string(proposal)
+	if _, err = c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Update(ctx, stored, metav1.UpdateOptions{}); err != nil {
+		return tenant, false, err
+	}
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolExpansionProposed",
+		fmt.Sprintf("Usage crossed %d%% of the capacity, set the %s annotation to %s to add the pool", tenant.Spec.AutoExpand.UsageThreshold, miniov2.AutoExpandApproveAnnotation, pool.Name))
+	return tenant, true, nil
+}
+
+// staleAutoExpandProposal returns why the proposed pool can't be added anymore, empty if it's still the pool that
+// would be proposed
+func staleAutoExpandProposal(tenant *miniov2.Tenant, pool miniov2.Pool) string {
+	if !autoExpandNeeded(tenant) {
+		return "the tenant doesn't need a new pool anymore"
+	}
+	if !equality.Semantic.DeepEqual(pool, autoExpandPool(tenant)) {
+		return "the pools or the pool template changed"
+	}
+	if err := pool.Validate(len(tenant.Spec.Pools)); err != nil {
+		return fmt.Sprintf("invalid pool template: %s", err)
+	}
+	return ""
+}
+
+// dropAutoExpandProposal removes the proposal annotations along with any approval of the proposed pool, a new pool is
+// proposed by a later sync if the tenant still needs one
+func (c *Controller) dropAutoExpandProposal(ctx context.Context, key string, tenant *miniov2.Tenant, reason string) (*miniov2.Tenant, bool, error) {
+	// get the tenant as stored, the one being synced has the defaults applied
+	stored, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Get(ctx, tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		return tenant, false, err
+	}
+	delete(stored.Annotations, miniov2.AutoExpandProposalAnnotation)
+	delete(stored.Annotations, miniov2.AutoExpandApproveAnnotation)
+	if _, err = c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Update(ctx, stored, metav1.UpdateOptions{}); err != nil {
+		return tenant, false, err
+	}
+	msg := fmt.Sprintf("Dropped the proposed pool: %s", reason)
+	klog.Infof("'%s' %s", key, msg)
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolExpansionDropped", msg)
+	return tenant, true, nil
+}
+
+// addAutoExpandPool appends the pool to the spec of the tenant and removes the proposal annotations
+func (c *Controller) addAutoExpandPool(ctx context.Context, tenant *miniov2.Tenant, pool miniov2.Pool) (*miniov2.Tenant, bool, error) {
+	// get the tenant as stored, the one being synced has the defaults applied
+	stored, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Get(ctx, tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		return tenant, false, err
+	}
+	stored.Spec.Pools = append(stored.Spec.Pools, pool)
+	delete(stored.Annotations, miniov2.AutoExpandProposalAnnotation)
+	delete(stored.Annotations, miniov2.AutoExpandApproveAnnotation)
+	updated, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Update(ctx, stored, metav1.UpdateOptions{})
+	if err != nil {
+		return tenant, false, err
+	}
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolAutoExpanded",
+		fmt.Sprintf("Usage crossed %d%% of the capacity, pool %s added", tenant.Spec.AutoExpand.UsageThreshold, pool.Name))
+
+	now := metav1.Now()
+	updated.Status.LastAutoExpansionTime = &now
+	if updated, err = c.updatePoolStatus(ctx, updated); err != nil {
+		return tenant, true, err
+	}
+	return updated, true, nil
+}
+
+// autoExpandNeeded returns true if the usage of a healthy tenant crossed the threshold and a new pool can be added.
+// Nothing is added while a pool is being created, decommissioned or doesn't report its capacity yet, so the usage
+// always accounts for the pools added before, nor until the cooldown since the last pool added elapsed.
+func autoExpandNeeded(tenant *miniov2.Tenant) bool {
+	autoExpand := tenant.Spec.AutoExpand
+	if autoExpand == nil || !autoExpand.Enabled || autoExpand.UsageThreshold <= 0 {
+		return false
+	}
+	if last := tenant.Status.LastAutoExpansionTime; last != nil && time.Since(last.Time) < tenant.AutoExpandCooldown() {
+		return false
+	}
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		return false
+	}
+	if int32(len(tenant.Spec.Pools)) >= autoExpand.MaxPools {
+		return false
+	}
+	if len(tenant.DecommissioningPools()) > 0 || len(tenant.Status.Pools) < len(tenant.Spec.Pools) {
+		return false
+	}
+	for i := range tenant.Spec.Pools {
+		pool := poolStatusForIndex(tenant, i)
+		if pool == nil || pool.State != miniov2.PoolInitialized || pool.Usage.RawCapacity <= 0 {
+			return false
+		}
+	}
+	usage := tenant.Status.Usage
+	if usage.Capacity <= 0 {
+		return false
+	}
+	return usage.Usage*100 >= int64(autoExpand.UsageThreshold)*usage.Capacity
+}
+
+// autoExpandPool returns the pool generated from the template, named after the template with the first number that
+// isn't used by a pool of the tenant
+func autoExpandPool(tenant *miniov2.Tenant) miniov2.Pool {
+	pool := *tenant.Spec.AutoExpand.PoolTemplate.DeepCopy()
+	prefix := pool.Name
+	if prefix == "" {
+		prefix = "pool"
+	}
+	used := map[string]bool{}
+	for _, p := range tenant.Spec.Pools {
+		used[p.Name] = true
+	}
+	for _, p := range tenant.Status.Pools {
+		used[p.SSName] = true
+	}
+	for i := len(tenant.Spec.Pools); ; i++ {
+		pool.Name = fmt.Sprintf("%s-%d", prefix, i)
+		if !used[pool.Name] && !used[tenant.PoolStatefulsetName(&pool)] {
+			return pool
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// autoExpandTenant returns a healthy tenant with one initialized pool using the given bytes out of 1000
+func autoExpandTenant(usage int64) *miniov2.Tenant {
+	return &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "myminio"},
+		Spec: miniov2.TenantSpec{
+			Pools: []miniov2.Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			AutoExpand: &miniov2.AutoExpand{
+				Enabled:        true,
+				UsageThreshold: 80,
+				MaxPools:       3,
+				PoolTemplate:   miniov2.Pool{Servers: 4, VolumesPerServer: 4},
+			},
+		},
+		Status: miniov2.TenantStatus{
+			HealthStatus: miniov2.HealthStatusGreen,
+			Pools: []miniov2.PoolStatus{
+				{SSName: "myminio-pool-0", State: miniov2.PoolInitialized, Usage: miniov2.PoolUsage{RawCapacity: 2000}},
+			},
+			Usage: miniov2.TenantUsage{Capacity: 1000, Usage: usage},
+		},
+	}
+}
+
+func TestAutoExpandNeeded(t *testing.T) {
+	tests := []struct {
+		name   string
+		tenant func() *miniov2.Tenant
+		want   bool
+	}{
+		{
+			name:   "Usage below threshold",
+			tenant: func() *miniov2.Tenant { return autoExpandTenant(799) },
+			want:   false,
+		},
+		{
+			name:   "Usage crossed threshold",
+			tenant: func() *miniov2.Tenant { return autoExpandTenant(800) },
+			want:   true,
+		},
+		{
+			name: "Disabled",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Spec.AutoExpand.Enabled = false
+				return tenant
+			},
+			want: false,
+		},
+		{
+			name: "Tenant not healthy",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Status.HealthStatus = miniov2.HealthStatusYellow
+				return tenant
+			},
+			want: false,
+		},
+		{
+			name: "Maximum number of pools reached",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Spec.AutoExpand.MaxPools = 1
+				return tenant
+			},
+			want: false,
+		},
+		{
+			name: "Previous pool still being created",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Spec.Pools = append(tenant.Spec.Pools, miniov2.Pool{Name: "pool-1", Servers: 4, VolumesPerServer: 4})
+				tenant.Status.Pools = append(tenant.Status.Pools, miniov2.PoolStatus{SSName: "myminio-pool-1", State: miniov2.PoolCreated})
+				return tenant
+			},
+			want: false,
+		},
+		{
+			name: "Pool added within the cooldown",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				last := metav1.NewTime(time.Now().Add(-30 * time.Minute))
+				tenant.Status.LastAutoExpansionTime = &last
+				return tenant
+			},
+			want: false,
+		},
+		{
+			name: "Pool added before the cooldown",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				last := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+				tenant.Status.LastAutoExpansionTime = &last
+				return tenant
+			},
+			want: true,
+		},
+		{
+			name: "Pool added before a custom cooldown",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				last := metav1.NewTime(time.Now().Add(-30 * time.Minute))
+				tenant.Status.LastAutoExpansionTime = &last
+				tenant.Spec.AutoExpand.Cooldown = &metav1.Duration{Duration: 10 * time.Minute}
+				return tenant
+			},
+			want: true,
+		},
+		{
+			name: "Usage not reported yet",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Status.Usage = miniov2.TenantUsage{}
+				return tenant
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := autoExpandNeeded(tt.tenant()); got != tt.want {
+				t.Errorf("autoExpandNeeded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoExpandPool(t *testing.T) {
+	tenant := autoExpandTenant(900)
+	if got := autoExpandPool(tenant).Name; got != "pool-1" {
+		t.Errorf("autoExpandPool() name = %v, want pool-1", got)
+	}
+
+	// pool-1 was decommissioned and is still tracked in the status
+	tenant.Status.Pools = append(tenant.Status.Pools, miniov2.PoolStatus{SSName: "myminio-pool-1", State: miniov2.PoolDecommissioned})
+	if got := autoExpandPool(tenant).Name; got != "pool-2" {
+		t.Errorf("autoExpandPool() name = %v, want pool-2", got)
+	}
+
+	tenant.Spec.AutoExpand.PoolTemplate.Name = "fast"
+	if got := autoExpandPool(tenant).Name; got != "fast-1" {
+		t.Errorf("autoExpandPool() name = %v, want fast-1", got)
+	}
+}
+
+func TestStaleAutoExpandProposal(t *testing.T) {
+	tests := []struct {
+		name   string
+		tenant func() *miniov2.Tenant
+		stale  bool
+	}{
+		{
+			name:   "Proposal still needed",
+			tenant: func() *miniov2.Tenant { return autoExpandTenant(900) },
+		},
+		{
+			name:   "Usage went back below the threshold",
+			tenant: func() *miniov2.Tenant { return autoExpandTenant(500) },
+			stale:  true,
+		},
+		{
+			name: "Maximum number of pools lowered",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Spec.AutoExpand.MaxPools = 1
+				return tenant
+			},
+			stale: true,
+		},
+		{
+			name: "Pool template changed",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Spec.AutoExpand.PoolTemplate.Servers = 8
+				return tenant
+			},
+			stale: true,
+		},
+		{
+			name: "Proposed name taken by a pool added by hand",
+			tenant: func() *miniov2.Tenant {
+				tenant := autoExpandTenant(900)
+				tenant.Spec.Pools = append(tenant.Spec.Pools, miniov2.Pool{Name: "pool-1", Servers: 4, VolumesPerServer: 4})
+				tenant.Status.Pools = append(tenant.Status.Pools, miniov2.PoolStatus{SSName: "myminio-pool-1", State: miniov2.PoolInitialized, Usage: miniov2.PoolUsage{RawCapacity: 2000}})
+				return tenant
+			},
+			stale: true,
+		},
+	}
+	// the pool template has to be valid for the proposal to be added
+	withClaimTemplate := func(tenant *miniov2.Tenant) *miniov2.Tenant {
+		tenant.Spec.AutoExpand.PoolTemplate.VolumeClaimTemplate = &corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		return tenant
+	}
+	// the pool proposed while the usage of the tenant crossed the threshold
+	proposed := autoExpandPool(withClaimTemplate(autoExpandTenant(900)))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := staleAutoExpandProposal(withClaimTemplate(tt.tenant()), proposed); (got != "") != tt.stale {
+				t.Errorf("staleAutoExpandProposal() = %q, want stale %v", got, tt.stale)
+			}
+		})
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/policy"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	bucketv1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// bucketResyncPeriod is how often the settings of a bucket are compared with its spec, to restore the ones changed
+// outside of the Bucket resource
+const bucketResyncPeriod = 5 * time.Minute
+
+// bucketAPI is the part of the MinIO and MinIO admin clients used to sync a bucket
+type bucketAPI interface {
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	MakeBucket(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error
+	RemoveBucket(ctx context.Context, bucketName string) error
+	ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
+	GetBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
+	SetBucketVersioning(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error
+	GetObjectLockConfig(ctx context.Context, bucketName string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error)
+	SetObjectLockConfig(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error
+	GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
+	SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error
+	RemoveBucketTagging(ctx context.Context, bucketName string) error
+	GetBucketPolicy(ctx context.Context, bucketName string) (string, error)
+	SetBucketPolicy(ctx context.Context, bucketName, policy string) error
+	GetBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+	SetBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
+}
+
+// tenantBucketClient syncs buckets with the MinIO and MinIO admin clients of a tenant
+type tenantBucketClient struct {
+	*minio.Client
+	*madmin.AdminClient
+}
+
+// newBucketAPI returns the client used to sync the buckets of the tenant
+func (c *Controller) newBucketAPI(ctx context.Context, tenant *miniov2.Tenant) (bucketAPI, error) {
+	tenantConfiguration, err := c.getTenantCredentials(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := tenant.NewMinIOUser(tenantConfiguration, c.getTransport())
+	if err != nil {
+		return nil, err
+	}
+	adminClient, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+	if err != nil {
+		return nil, err
+	}
+	return tenantBucketClient{Client: minioClient, AdminClient: adminClient}, nil
+}
+
+// enqueueBucket takes a Bucket resource and puts its namespace/name key onto the bucket queue
+func (c *Controller) enqueueBucket(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	if !c.namespacesToWatch.IsEmpty() {
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			runtime.HandleError(err)
+			return
+		}
+		if !c.namespacesToWatch.Contains(object.GetNamespace()) {
+			klog.Infof("Ignoring bucket `%s` in namespace that is not watched by this controller.", key)
+			return
+		}
+	}
+	c.bucketQueue.Add(key)
+}
+
+// runBucketWorker processes the Buckets of the bucket queue
+func (c *Controller) runBucketWorker() {
+	defer runtime.HandleCrash()
+	for processNextItem(c.bucketQueue, c.syncBucketHandler) {
+	}
+}
+
+// syncBucketHandler creates the bucket of a Bucket resource in its tenant and keeps its settings in sync with the spec
+func (c *Controller) syncBucketHandler(key string) (Result, error) {
+	ctx := context.Background()
+	namespace, name := key2NamespaceName(key)
+	bucket, err := c.bucketLister.Buckets(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, nil)
+		}
+		return WrapResult(Result{}, err)
+	}
+	bucket = bucket.DeepCopy()
+
+	tenant, err := c.minioClientSet.MinioV2().Tenants(namespace).Get(ctx, bucket.Spec.Tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, err)
+		}
+		tenant = nil
+	}
+
+	if bucket.DeletionTimestamp != nil {
+		return c.finalizeBucket(ctx, key, bucket, tenant)
+	}
+	if !controllerutil.ContainsFinalizer(bucket, bucketv1alpha1.BucketFinalizer) {
+		controllerutil.AddFinalizer(bucket, bucketv1alpha1.BucketFinalizer)
+		// the update queues the bucket again
+		_, err = c.minioClientSet.BucketV1alpha1().Buckets(namespace).Update(ctx, bucket, metav1.UpdateOptions{})
+		return WrapResult(Result{}, err)
+	}
+
+	if err = bucket.Validate(); err != nil {
+		return WrapResult(Result{}, c.updateBucketReady(ctx, bucket, metav1.ConditionFalse, "InvalidSpec", err.Error()))
+	}
+	if tenant == nil {
+		msg := fmt.Sprintf("Tenant %s not found", bucket.Spec.Tenant.Name)
+		return WrapResult(Result{RequeueAfter: 30 * time.Second}, c.updateBucketReady(ctx, bucket, metav1.ConditionFalse, "TenantNotFound", msg))
+	}
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		msg := fmt.Sprintf("Waiting for tenant %s to be healthy", tenant.Name)
+		return WrapResult(Result{RequeueAfter: 10 * time.Second}, c.updateBucketReady(ctx, bucket, metav1.ConditionFalse, "TenantNotReady", msg))
+	}
+
+	api, err := c.newBucketAPI(ctx, tenant)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+	changed, err := syncBucket(ctx, api, bucket)
+	if err != nil {
+		klog.Errorf("'%s' Failed to sync bucket %s: %v", key, bucket.BucketName(), err)
+		c.recorder.Event(bucket, corev1.EventTypeWarning, "BucketSyncFailed", err.Error())
+		if uerr := c.updateBucketReady(ctx, bucket, metav1.ConditionFalse, "SyncFailed", err.Error()); uerr != nil {
+			return WrapResult(Result{}, uerr)
+		}
+		return WrapResult(Result{}, err)
+	}
+
+	// the generation was already synced, so the settings changed in MinIO
+	if len(changed) > 0 && bucket.Status.ObservedGeneration == bucket.Generation {
+		msg := fmt.Sprintf("Restored the settings changed outside of the Bucket resource: %s", strings.Join(changed, ", "))
+		klog.Infof("'%s' %s", key, msg)
+		c.recorder.Event(bucket, corev1.EventTypeWarning, "BucketDrift", msg)
+		now := metav1.Now()
+		bucket.Status.Drift = changed
+		bucket.Status.LastDriftTime = &now
+	} else if len(changed) > 0 {
+		c.recorder.Event(bucket, corev1.EventTypeNormal, "BucketSynced", fmt.Sprintf("Bucket %s synced: %s", bucket.BucketName(), strings.Join(changed, ", ")))
+	}
+	bucket.Status.Name = bucket.BucketName()
+	bucket.Status.ObservedGeneration = bucket.Generation
+	if err = c.updateBucketReady(ctx, bucket, metav1.ConditionTrue, "BucketSynced", "The bucket matches its spec"); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	// the settings of the bucket can be changed outside of the operator, check them again from time to time
+	return WrapResult(Result{RequeueAfter: bucketResyncPeriod}, nil)
+}
+
+// finalizeBucket applies the deletion policy of a deleted Bucket, a bucket that isn't empty is kept until it is
+func (c *Controller) finalizeBucket(ctx context.Context, key string, bucket *bucketv1alpha1.Bucket, tenant *miniov2.Tenant) (Result, error) {
+	if !controllerutil.ContainsFinalizer(bucket, bucketv1alpha1.BucketFinalizer) {
+		return WrapResult(Result{}, nil)
+	}
+	// the bucket is gone along with a deleted tenant
+	if bucket.DeletesBucket() && bucket.Status.Name != "" && tenant != nil && tenant.DeletionTimestamp == nil {
+		api, err := c.newBucketAPI(ctx, tenant)
+		if err != nil {
+			return WrapResult(Result{}, err)
+		}
+		deleted, err := deleteEmptyBucket(ctx, api, bucket.Status.Name)
+		if err != nil {
+			return WrapResult(Result{}, err)
+		}
+		if !deleted {
+			msg := fmt.Sprintf("Bucket %s isn't empty, it's deleted once its objects are removed", bucket.Status.Name)
+			c.recorder.Event(bucket, corev1.EventTypeWarning, "BucketNotEmpty", msg)
+			return WrapResult(Result{RequeueAfter: time.Minute}, c.updateBucketReady(ctx, bucket, metav1.ConditionFalse, "BucketNotEmpty", msg))
+		}
+		klog.Infof("'%s' Deleted bucket %s", key, bucket.Status.Name)
+	}
+	controllerutil.RemoveFinalizer(bucket, bucketv1alpha1.BucketFinalizer)
+	if _, err := c.minioClientSet.BucketV1alpha1().Buckets(bucket.Namespace).Update(ctx, bucket, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return WrapResult(Result{}, err)
+	}
+	return WrapResult(Result{}, nil)
+}
+
+// updateBucketReady sets the Ready condition of the bucket and persists its status if it changed
+func (c *Controller) updateBucketReady(ctx context.Context, bucket *bucketv1alpha1.Bucket, status metav1.ConditionStatus, reason, msg string) error {
+	current, err := c.bucketLister.Buckets(bucket.Namespace).Get(bucket.Name)
+	if err != nil {
+		return err
+	}
+	updated := bucket.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+		Type:               bucketv1alpha1.BucketConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: bucket.Generation,
+	})
+	if equality.Semantic.DeepEqual(current.Status, updated.Status) {
+		return nil
+	}
+	_, err = c.minioClientSet.BucketV1alpha1().Buckets(bucket.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// syncBucket creates the bucket if it doesn't exist and applies the settings of its spec. Returns the settings that
+// were changed.
+func syncBucket(ctx context.Context, api bucketAPI, bucket *bucketv1alpha1.Bucket) ([]string, error) {
+	name := bucket.BucketName()
+	spec := bucket.Spec
+	var changed []string
+
+	exists, err := api.BucketExists(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err = api.MakeBucket(ctx, name, minio.MakeBucketOptions{Region: spec.Region, ObjectLocking: spec.ObjectLocking}); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "bucket")
+	}
+
+	if spec.Versioning != "" {
+		versioning, err := api.GetBucketVersioning(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if versioning.Status != string(spec.Versioning) {
+			if err = api.SetBucketVersioning(ctx, name, minio.BucketVersioningConfiguration{Status: string(spec.Versioning)}); err != nil {
+				return nil, err
+			}
+			changed = append(changed, "versioning")
+		}
+	}
+
+	if spec.ObjectLocking {
+		_, mode, validity, unit, err := api.GetObjectLockConfig(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		wantMode, wantValidity, wantUnit := bucketRetention(spec.Retention)
+		if !reflect.DeepEqual(mode, wantMode) || !reflect.DeepEqual(validity, wantValidity) || !reflect.DeepEqual(unit, wantUnit) {
+			if err = api.SetObjectLockConfig(ctx, name, wantMode, wantValidity, wantUnit); err != nil {
+				return nil, err
+			}
+			changed = append(changed, "retention")
+		}
+	}
+
+	quota, err := api.GetBucketQuota(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminBucketQuotaConfigNotFound" {
+		return nil, err
+	}
+	var wantQuota uint64
+	if spec.Quota != nil {
+		wantQuota = uint64(spec.Quota.Value())
+	}
+	currentQuota := quota.Size
+	if currentQuota == 0 {
+		currentQuota = quota.Quota
+	}
+	if currentQuota != wantQuota {
+		newQuota := &madmin.BucketQuota{}
+		if wantQuota > 0 {
+			newQuota = &madmin.BucketQuota{Quota: wantQuota, Size: wantQuota, Type: madmin.HardQuota}
+		}
+		if err = api.SetBucketQuota(ctx, name, newQuota); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "quota")
+	}
+
+	currentTags := map[string]string{}
+	bucketTags, err := api.GetBucketTagging(ctx, name)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchTagSet" {
+		return nil, err
+	}
+	if bucketTags != nil {
+		currentTags = bucketTags.ToMap()
+	}
+	if !equality.Semantic.DeepEqual(currentTags, spec.Tags) && (len(currentTags) > 0 || len(spec.Tags) > 0) {
+		if len(spec.Tags) == 0 {
+			err = api.RemoveBucketTagging(ctx, name)
+		} else {
+			var newTags *tags.Tags
+			if newTags, err = tags.MapToBucketTags(spec.Tags); err != nil {
+				return nil, err
+			}
+			err = api.SetBucketTagging(ctx, name, newTags)
+		}
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, "tags")
+	}
+
+	currentPolicy, err := api.GetBucketPolicy(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var accessPolicy policy.BucketAccessPolicy
+	if currentPolicy != "" {
+		if err = json.Unmarshal([]byte(currentPolicy), &accessPolicy); err != nil {
+			return nil, err
+		}
+	}
+	wantAccess := anonymousBucketPolicy(spec.AnonymousAccess)
+	if policy.GetPolicy(accessPolicy.Statements, name, "") != wantAccess {
+		accessPolicy.Version = "2012-10-17"
+		accessPolicy.Statements = policy.SetPolicy(accessPolicy.Statements, wantAccess, name, "")
+		newPolicy := ""
+		if len(accessPolicy.Statements) > 0 {
+			policyJSON, err := json.Marshal(accessPolicy)
+			if err != nil {
+				return nil, err
+			}
+			newPolicy = string(policyJSON)
+		}
+		if err = api.SetBucketPolicy(ctx, name, newPolicy); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "anonymousAccess")
+	}
+	return changed, nil
+}
+
+// deleteEmptyBucket deletes the bucket if it has no objects nor versions. Returns false if the bucket isn't empty.
+func deleteEmptyBucket(ctx context.Context, api bucketAPI, name string) (bool, error) {
+	exists, err := api.BucketExists(ctx, name)
+	if err != nil || !exists {
+		return err == nil, err
+	}
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for object := range api.ListObjects(listCtx, name, minio.ListObjectsOptions{Recursive: true, WithVersions: true, MaxKeys: 1}) {
+		if object.Err != nil {
+			return false, object.Err
+		}
+		return false, nil
+	}
+	if err = api.RemoveBucket(ctx, name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// bucketRetention returns the object lock settings of the retention, all nil to remove the default retention
+func bucketRetention(retention *bucketv1alpha1.BucketRetention) (*minio.RetentionMode, *uint, *minio.ValidityUnit) {
+	if retention == nil {
+		return nil, nil, nil
+	}
+	mode := minio.Governance
+	if retention.Mode == bucketv1alpha1.RetentionCompliance {
+		mode = minio.Compliance
+	}
+	validity, unit := uint(retention.Days), minio.Days
+	if retention.Years > 0 {
+		validity, unit = uint(retention.Years), minio.Years
+	}
+	return &mode, &validity, &unit
+}
+
+// anonymousBucketPolicy returns the canned bucket policy granting the anonymous access
+func anonymousBucketPolicy(access bucketv1alpha1.AnonymousAccess) policy.BucketPolicy {
+	switch access {
+	case bucketv1alpha1.AnonymousDownload:
+		return policy.BucketPolicyReadOnly
+	case bucketv1alpha1.AnonymousUpload:
+		return policy.BucketPolicyWriteOnly
+	case bucketv1alpha1.AnonymousPublic:
+		return policy.BucketPolicyReadWrite
+	default:
+		return policy.BucketPolicyNone
+	}
+}
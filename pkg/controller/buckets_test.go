@@ -0,0 +1,189 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	bucketv1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeBucketAPI keeps the settings of a single bucket in memory
+type fakeBucketAPI struct {
+	exists     bool
+	objects    int
+	versioning string
+	mode       *minio.RetentionMode
+	validity   *uint
+	unit       *minio.ValidityUnit
+	quota      uint64
+	tags       map[string]string
+	policy     string
+}
+
+func (f *fakeBucketAPI) BucketExists(_ context.Context, _ string) (bool, error) {
+	return f.exists, nil
+}
+
+func (f *fakeBucketAPI) MakeBucket(_ context.Context, _ string, _ minio.MakeBucketOptions) error {
+	f.exists = true
+	return nil
+}
+
+func (f *fakeBucketAPI) RemoveBucket(_ context.Context, _ string) error {
+	f.exists = false
+	return nil
+}
+
+func (f *fakeBucketAPI) ListObjects(_ context.Context, _ string, _ minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	objects := make(chan minio.ObjectInfo, f.objects)
+	for i := 0; i < f.objects; i++ {
+		objects <- minio.ObjectInfo{Key: "object"}
+	}
+	close(objects)
+	return objects
+}
+
+func (f *fakeBucketAPI) GetBucketVersioning(_ context.Context, _ string) (minio.BucketVersioningConfiguration, error) {
+	return minio.BucketVersioningConfiguration{Status: f.versioning}, nil
+}
+
+func (f *fakeBucketAPI) SetBucketVersioning(_ context.Context, _ string, config minio.BucketVersioningConfiguration) error {
+	f.versioning = config.Status
+	return nil
+}
+
+func (f *fakeBucketAPI) GetObjectLockConfig(_ context.Context, _ string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+	return "Enabled", f.mode, f.validity, f.unit, nil
+}
+
+func (f *fakeBucketAPI) SetObjectLockConfig(_ context.Context, _ string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+	f.mode, f.validity, f.unit = mode, validity, unit
+	return nil
+}
+
+func (f *fakeBucketAPI) GetBucketTagging(_ context.Context, _ string) (*tags.Tags, error) {
+	if len(f.tags) == 0 {
+		return nil, minio.ErrorResponse{Code: "NoSuchTagSet"}
+	}
+	return tags.MapToBucketTags(f.tags)
+}
+
+func (f *fakeBucketAPI) SetBucketTagging(_ context.Context, _ string, bucketTags *tags.Tags) error {
+	f.tags = bucketTags.ToMap()
+	return nil
+}
+
+func (f *fakeBucketAPI) RemoveBucketTagging(_ context.Context, _ string) error {
+	f.tags = nil
+	return nil
+}
+
+func (f *fakeBucketAPI) GetBucketPolicy(_ context.Context, _ string) (string, error) {
+	return f.policy, nil
+}
+
+func (f *fakeBucketAPI) SetBucketPolicy(_ context.Context, _, policy string) error {
+	f.policy = policy
+	return nil
+}
+
+func (f *fakeBucketAPI) GetBucketQuota(_ context.Context, _ string) (madmin.BucketQuota, error) {
+	return madmin.BucketQuota{Size: f.quota, Quota: f.quota}, nil
+}
+
+func (f *fakeBucketAPI) SetBucketQuota(_ context.Context, _ string, quota *madmin.BucketQuota) error {
+	f.quota = quota.Size
+	return nil
+}
+
+func TestSyncBucket(t *testing.T) {
+	quota := resource.MustParse("1Gi")
+	bucket := &bucketv1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{Name: "data"},
+		Spec: bucketv1alpha1.BucketSpec{
+			Tenant:          bucketv1alpha1.TenantReference{Name: "myminio"},
+			ObjectLocking:   true,
+			Versioning:      bucketv1alpha1.VersioningEnabled,
+			Retention:       &bucketv1alpha1.BucketRetention{Mode: bucketv1alpha1.RetentionGovernance, Days: 30},
+			Quota:           &quota,
+			Tags:            map[string]string{"team": "storage"},
+			AnonymousAccess: bucketv1alpha1.AnonymousDownload,
+		},
+	}
+	api := &fakeBucketAPI{}
+	ctx := context.Background()
+
+	changed, err := syncBucket(ctx, api, bucket)
+	if err != nil {
+		t.Fatalf("syncBucket() error = %v", err)
+	}
+	want := []string{"bucket", "versioning", "retention", "quota", "tags", "anonymousAccess"}
+	if !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncBucket() changed = %v, want %v", changed, want)
+	}
+	if api.quota != uint64(quota.Value()) || *api.validity != 30 || *api.unit != minio.Days {
+		t.Errorf("syncBucket() didn't apply the quota and retention: %+v", api)
+	}
+
+	if changed, err = syncBucket(ctx, api, bucket); err != nil || len(changed) != 0 {
+		t.Errorf("syncBucket() of a bucket in sync changed = %v, error = %v", changed, err)
+	}
+
+	// settings changed outside of the Bucket resource are restored
+	api.tags = map[string]string{"team": "other"}
+	api.policy = ""
+	changed, err = syncBucket(ctx, api, bucket)
+	if err != nil {
+		t.Fatalf("syncBucket() error = %v", err)
+	}
+	if want = []string{"tags", "anonymousAccess"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncBucket() changed = %v, want %v", changed, want)
+	}
+
+	// removing settings from the spec removes them from the bucket
+	bucket.Spec.Retention = nil
+	bucket.Spec.Quota = nil
+	bucket.Spec.Tags = nil
+	bucket.Spec.AnonymousAccess = bucketv1alpha1.AnonymousNone
+	if _, err = syncBucket(ctx, api, bucket); err != nil {
+		t.Fatalf("syncBucket() error = %v", err)
+	}
+	if api.mode != nil || api.quota != 0 || len(api.tags) != 0 || api.policy != "" {
+		t.Errorf("syncBucket() didn't remove the settings: %+v", api)
+	}
+}
+
+func TestDeleteEmptyBucket(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeBucketAPI{exists: true, objects: 1}
+	if deleted, err := deleteEmptyBucket(ctx, api, "data"); err != nil || deleted || !api.exists {
+		t.Errorf("deleteEmptyBucket() of a bucket with objects = %v, %v", deleted, err)
+	}
+	api.objects = 0
+	if deleted, err := deleteEmptyBucket(ctx, api, "data"); err != nil || !deleted || api.exists {
+		t.Errorf("deleteEmptyBucket() of an empty bucket = %v, %v", deleted, err)
+	}
+	if deleted, err := deleteEmptyBucket(ctx, api, "data"); err != nil || !deleted {
+		t.Errorf("deleteEmptyBucket() of a missing bucket = %v, %v", deleted, err)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// configAPI is the part of the MinIO admin client used to sync the server configuration
+type configAPI interface {
+	GetConfigKV(ctx context.Context, key string) ([]byte, error)
+	SetConfigKV(ctx context.Context, kv string) (restart bool, err error)
+	ServiceRestart(ctx context.Context) error
+}
+
+// syncTenantConfig applies `spec.config` to MinIO and records drift in the status of the tenant. MinIO is restarted
+// in a maintenance window when a changed parameter requires it.
+func (c *Controller) syncTenantConfig(ctx context.Context, key string, tenant *miniov2.Tenant, tenantConfiguration map[string][]byte) (*miniov2.Tenant, error) {
+	if len(tenant.Spec.Config) == 0 && tenant.Status.Config == nil && !configRestartPending(tenant) {
+		return tenant, nil
+	}
+	adminClient, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+	if err != nil {
+		return nil, err
+	}
+	return c.applyTenantConfig(ctx, key, tenant, adminClient)
+}
+
+// configRestartPending returns true if a restart to apply the config is still due, operators that didn't persist the
+// flag in the config status only kept the queued maintenance operation
+func configRestartPending(tenant *miniov2.Tenant) bool {
+	if tenant.Status.Config != nil && tenant.Status.Config.RestartRequired {
+		return true
+	}
+	for _, p := range tenant.Status.PendingMaintenance {
+		if p.Operation == miniov2.MaintenanceConfigRestart {
+			return true
+		}
+	}
+	return false
+}
+
+// applyTenantConfig sets `spec.config` through the given API. The restart it requires is persisted in the status
+// before MinIO is restarted, so it's not lost if the restart fails or the operator stops.
+func (c *Controller) applyTenantConfig(ctx context.Context, key string, tenant *miniov2.Tenant, api configAPI) (*miniov2.Tenant, error) {
+	var err error
+	restartPending := configRestartPending(tenant)
+	updated := tenant.DeepCopy()
+	// parameters removed from the spec keep their value in MinIO, there is nothing left to track
+	updated.Status.Config = nil
+	if len(tenant.Spec.Config) > 0 {
+		changed, restart, err := syncConfig(ctx, api, tenant.Spec.Config)
+		if err != nil {
+			klog.Errorf("'%s' Failed to apply the config: %v", key, err)
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "ConfigFailed", fmt.Sprintf("Failed to apply the config: %s", err))
+			return nil, err
+		}
+		hash, err := configHash(tenant.Spec.Config)
+		if err != nil {
+			return nil, err
+		}
+		status := &miniov2.TenantConfigStatus{AppliedHash: hash}
+		applied := tenant.Status.Config != nil && tenant.Status.Config.AppliedHash == hash
+		if applied {
+			status.Drift = tenant.Status.Config.Drift
+			status.LastDriftTime = tenant.Status.Config.LastDriftTime
+		}
+		// the config was already applied, so it changed in MinIO
+		if len(changed) > 0 && applied {
+			msg := fmt.Sprintf("Restored the config changed outside of the Tenant: %s", strings.Join(changed, ", "))
+			klog.Infof("'%s' %s", key, msg)
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "ConfigDrift", msg)
+			now := metav1.Now()
+			status.Drift = changed
+			status.LastDriftTime = &now
+		} else if len(changed) > 0 {
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "ConfigApplied", fmt.Sprintf("Config applied: %s", strings.Join(changed, ", ")))
+		}
+		updated.Status.Config = status
+		restartPending = restartPending || restart
+	}
+	if restartPending {
+		if updated.Status.Config == nil {
+			updated.Status.Config = &miniov2.TenantConfigStatus{}
+		}
+		updated.Status.Config.RestartRequired = true
+	}
+	if !equality.Semantic.DeepEqual(tenant.Status.Config, updated.Status.Config) {
+		if tenant, err = c.updatePoolStatus(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+	if !restartPending {
+		return tenant, nil
+	}
+
+	var allowed bool
+	if tenant, allowed, err = c.checkMaintenanceWindow(ctx, key, tenant, miniov2.MaintenanceConfigRestart, "Restart MinIO to apply the config"); err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return tenant, nil
+	}
+	if err = api.ServiceRestart(ctx); err != nil {
+		klog.Errorf("'%s' Failed to restart MinIO to apply the config: %v", key, err)
+		c.recorder.Event(tenant, corev1.EventTypeWarning, "ConfigRestartFailed", fmt.Sprintf("Failed to restart MinIO to apply the config: %s", err))
+		return nil, err
+	}
+	klog.Infof("'%s' Restarted MinIO to apply the config", key)
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "ConfigRestart", "Restarted MinIO to apply the config")
+	updated = tenant.DeepCopy()
+	if updated.Status.Config != nil {
+		updated.Status.Config.RestartRequired = false
+		if equality.Semantic.DeepEqual(*updated.Status.Config, miniov2.TenantConfigStatus{}) {
+			updated.Status.Config = nil
+		}
+	}
+	return c.updatePoolStatus(ctx, updated)
+}
+
+// syncConfig sets the parameters of the config that differ from the ones in MinIO. Returns the parameters that were
+// changed, as `subsystem parameter`, and true if MinIO has to restart to apply them.
+func syncConfig(ctx context.Context, api configAPI, config map[string]map[string]string) ([]string, bool, error) {
+	subsystems := make([]string, 0, len(config))
+	for subsys := range config {
+		subsystems = append(subsystems, subsys)
+	}
+	sort.Strings(subsystems)
+
+	var changed []string
+	var restart bool
+	for _, subsys := range subsystems {
+		current, err := getConfigKVs(ctx, api, subsys)
+		if err != nil {
+			return nil, false, err
+		}
+		keys := make([]string, 0, len(config[subsys]))
+		for k := range config[subsys] {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var kvs []string
+		for _, k := range keys {
+			v := config[subsys][k]
+			if value, ok := current[k]; ok && value == v {
+				continue
+			}
+			kvs = append(kvs, fmt.Sprintf("%s=\"%s\"", k, v))
+			changed = append(changed, fmt.Sprintf("%s %s", subsys, k))
+		}
+		if len(kvs) == 0 {
+			continue
+		}
+		subsysRestart, err := api.SetConfigKV(ctx, fmt.Sprintf("%s %s", subsys, strings.Join(kvs, " ")))
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: %w", subsys, err)
+		}
+		restart = restart || subsysRestart
+	}
+	return changed, restart, nil
+}
+
+// getConfigKVs returns the values stored in MinIO for the subsystem, the environment variables that override them are
+// ignored
+func getConfigKVs(ctx context.Context, api configAPI, subsys string) (map[string]string, error) {
+	name, target, _ := strings.Cut(subsys, madmin.SubSystemSeparator)
+	out, err := api.GetConfigKV(ctx, subsys)
+	if err != nil {
+		// MinIO doesn't know a target until it's set
+		if target != "" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", subsys, err)
+	}
+	configs, err := madmin.ParseServerConfigOutput(string(out))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", subsys, err)
+	}
+	kvs := map[string]string{}
+	for _, cfg := range configs {
+		if cfg.SubSystem != name || cfg.Target != target {
+			continue
+		}
+		for _, kv := range cfg.KV {
+			kvs[kv.Key] = kv.Value
+		}
+	}
+	return kvs, nil
+}
+
+// configHash identifies a version of `spec.config`, the keys of the marshalled maps are sorted
+func configHash(config map[string]map[string]string) (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	miniofake "github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeConfigAPI keeps the config of a tenant in memory, as `subsystem[:target]` => parameter => value
+type fakeConfigAPI struct {
+	config     map[string]map[string]string
+	restart    map[string]bool
+	sets       int
+	restarts   int
+	restartErr error
+}
+
+func (f *fakeConfigAPI) GetConfigKV(_ context.Context, key string) ([]byte, error) {
+	kvs, ok := f.config[key]
+	if !ok {
+		return nil, errors.New("no such target")
+	}
+	var params []string
+	for k, v := range kvs {
+		params = append(params, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(params)
+	out := fmt.Sprintf("%s %s\n", key, strings.Join(params, " "))
+	// MinIO reports the environment variables that override a parameter in comments
+	if key == "api" {
+		out = "# MINIO_API_CORS_ALLOW_ORIGIN=*\n" + out
+	}
+	return []byte(out), nil
+}
+
+func (f *fakeConfigAPI) SetConfigKV(_ context.Context, kv string) (bool, error) {
+	key, params, _ := strings.Cut(kv, " ")
+	if f.config[key] == nil {
+		f.config[key] = map[string]string{}
+	}
+	for _, param := range strings.Fields(params) {
+		k, v, _ := strings.Cut(param, "=")
+		f.config[key][k] = strings.Trim(v, `"`)
+	}
+	f.sets++
+	return f.restart[strings.Split(key, ":")[0]], nil
+}
+
+func (f *fakeConfigAPI) ServiceRestart(_ context.Context) error {
+	if f.restartErr != nil {
+		return f.restartErr
+	}
+	f.restarts++
+	return nil
+}
+
+func TestSyncConfig(t *testing.T) {
+	api := &fakeConfigAPI{
+		config: map[string]map[string]string{
+			"api":     {"requests_max": "0", "cors_allow_origin": "https://example.com"},
+			"scanner": {"speed": "default"},
+		},
+		restart: map[string]bool{"scanner": true},
+	}
+	config := map[string]map[string]string{
+		"api":                    {"requests_max": "1000", "cors_allow_origin": "https://example.com"},
+		"notify_webhook:primary": {"enable": "on", "endpoint": "http://webhook:8080"},
+	}
+	ctx := context.Background()
+
+	changed, restart, err := syncConfig(ctx, api, config)
+	want := []string{"api requests_max", "notify_webhook:primary enable", "notify_webhook:primary endpoint"}
+	if err != nil || restart || !reflect.DeepEqual(changed, want) {
+		t.Fatalf("syncConfig() = %v, %v, %v, want %v", changed, restart, err, want)
+	}
+	if api.config["api"]["requests_max"] != "1000" || api.config["notify_webhook:primary"]["enable"] != "on" {
+		t.Errorf("syncConfig() didn't set the config: %v", api.config)
+	}
+
+	// nothing is set when MinIO matches the config
+	sets := api.sets
+	if changed, _, err = syncConfig(ctx, api, config); err != nil || len(changed) > 0 || api.sets != sets {
+		t.Errorf("syncConfig() of a config in sync = %v, %v", changed, err)
+	}
+
+	// a parameter changed outside of the tenant is restored, and MinIO says when it has to restart
+	api.config["api"]["requests_max"] = "0"
+	config["scanner"] = map[string]string{"speed": "slow"}
+	changed, restart, err = syncConfig(ctx, api, config)
+	want = []string{"api requests_max", "scanner speed"}
+	if err != nil || !restart || !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncConfig() of a changed config = %v, %v, %v, want %v", changed, restart, err, want)
+	}
+
+	// an unknown subsystem is an error
+	if _, _, err = syncConfig(ctx, api, map[string]map[string]string{"unknown": {"key": "value"}}); err == nil {
+		t.Errorf("syncConfig() of an unknown subsystem didn't fail")
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	a, _ := configHash(map[string]map[string]string{"api": {"requests_max": "1000", "cors_allow_origin": "*"}, "scanner": {"speed": "slow"}})
+	b, _ := configHash(map[string]map[string]string{"scanner": {"speed": "slow"}, "api": {"cors_allow_origin": "*", "requests_max": "1000"}})
+	c, _ := configHash(map[string]map[string]string{"api": {"requests_max": "1000", "cors_allow_origin": "*"}})
+	if a != b || a == c {
+		t.Errorf("configHash() = %s, %s, %s", a, b, c)
+	}
+}
+
+func TestApplyTenantConfigRestart(t *testing.T) {
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns"},
+		Spec: miniov2.TenantSpec{
+			Config: map[string]map[string]string{"scanner": {"speed": "slow"}},
+		},
+	}
+	api := &fakeConfigAPI{
+		config:     map[string]map[string]string{"scanner": {"speed": "default"}},
+		restart:    map[string]bool{"scanner": true},
+		restartErr: errors.New("restart failed"),
+	}
+	c := &Controller{
+		minioClientSet: miniofake.NewSimpleClientset(tenant.DeepCopy()),
+		recorder:       record.NewFakeRecorder(100),
+	}
+	// the fake clientset drops the spec on status updates, the stored status is put back on the tenant
+	stored := func() *miniov2.Tenant {
+		t.Helper()
+		updated, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), "tenant", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		next := tenant.DeepCopy()
+		next.ResourceVersion = updated.ResourceVersion
+		next.Status = updated.Status
+		return next
+	}
+
+	// the parameter is set but MinIO fails to restart
+	if _, err := c.applyTenantConfig(context.Background(), "ns/tenant", tenant, api); err == nil {
+		t.Fatal("applyTenantConfig() expected the restart to fail")
+	}
+	if config := stored().Status.Config; config == nil || !config.RestartRequired {
+		t.Fatalf("restart required must be kept in the status, got %+v", config)
+	}
+
+	// the parameter already has its value, the restart is still due
+	api.restartErr = nil
+	if _, err := c.applyTenantConfig(context.Background(), "ns/tenant", stored(), api); err != nil {
+		t.Fatalf("applyTenantConfig() error = %v", err)
+	}
+	if api.sets != 1 || api.restarts != 1 {
+		t.Errorf("applyTenantConfig() set the config %d times and restarted %d times, want 1 and 1", api.sets, api.restarts)
+	}
+	config := stored().Status.Config
+	if config == nil || config.RestartRequired || config.AppliedHash == "" {
+		t.Errorf("restart required must be cleared once MinIO restarted, got %+v", config)
+	}
+
+	// nothing left to restart
+	if _, err := c.applyTenantConfig(context.Background(), "ns/tenant", stored(), api); err != nil {
+		t.Fatalf("applyTenantConfig() error = %v", err)
+	}
+	if api.restarts != 1 {
+		t.Errorf("applyTenantConfig() restarted %d times, want 1", api.restarts)
+	}
+}
@@ -36,6 +36,8 @@ import (
 
 	"k8s.io/klog/v2"
 
+	bucketv1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
 	"github.com/minio/operator/pkg/apis/job.min.io/v1alpha1"
 	v2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	stsv1beta1 "github.com/minio/operator/pkg/apis/sts.min.io/v1beta1"
@@ -78,6 +80,8 @@ func init() {
 func StartOperator(kubeconfig string) {
 	_ = v2.AddToScheme(scheme.Scheme)
 	_ = v1alpha1.AddToScheme(scheme.Scheme)
+	_ = bucketv1alpha1.AddToScheme(scheme.Scheme)
+	_ = iamv1alpha1.AddToScheme(scheme.Scheme)
 	_ = stsv1beta1.AddToScheme(scheme.Scheme)
 	_ = stsv1alpha1.AddToScheme(scheme.Scheme)
 	klog.Info("Starting MinIO Operator")
@@ -170,6 +174,11 @@ func StartOperator(kubeconfig string) {
 		minioInformerFactory.Minio().V2().Tenants(),
 		minioInformerFactory.Sts().V1beta1().PolicyBindings(),
 		minioInformerFactory.Job().V1alpha1().MinIOJobs(),
+		minioInformerFactory.Bucket().V1alpha1().Buckets(),
+		minioInformerFactory.Iam().V1alpha1().MinIOPolicies(),
+		minioInformerFactory.Iam().V1alpha1().MinIOUsers(),
+		minioInformerFactory.Iam().V1alpha1().MinIOGroups(),
+		minioInformerFactory.Iam().V1alpha1().MinIOAccessKeys(),
 		kubeInformerFactoryInOperatorNamespace,
 	)
 
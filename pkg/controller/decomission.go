@@ -18,7 +18,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio-go/v7/pkg/set"
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	corev1 "k8s.io/api/core/v1"
@@ -49,12 +53,13 @@ func (c *Controller) checkForPoolDecommission(ctx context.Context, key string, t
 		}
 	}
 
+	// pools added back to the spec while they are being drained cancel their decommission
+	if tenant, err = c.cancelPoolDecommission(ctx, key, tenant, tenantConfiguration); err != nil {
+		return nil, err
+	}
+
 	// if the number of pools in the spec is less that what we know in the status, a decomission is taking place
 	if len(tenant.Status.Pools) > len(tenant.Spec.Pools) {
-		err := c.DeletePDB(ctx, tenant)
-		if err != nil {
-			return nil, err
-		}
 		// check for empty pool names
 		var noDecom bool
 		for _, pool := range tenant.Spec.Pools {
@@ -83,12 +88,15 @@ func (c *Controller) checkForPoolDecommission(ctx context.Context, key string, t
 		}
 		if noDecomCommon {
 			klog.Warningf("%s Detected we are removing a pool but spec.Pool[].Name's are duplicated - disallowing removal", key)
+			if tenant, err = c.updateTenantStatus(ctx, tenant, StatusDecommissioningNotAllowed, 0); err != nil {
+				return nil, err
+			}
 			return nil, errors.New("removing pool not allowed")
 		}
 
 		klog.Infof("%s Detected we are removing a pool", key)
 		// This means we are attempting to remove a "pool", perhaps after a decommission event.
-		var poolNamesRemoved []string
+		var removedPools []miniov2.PoolStatus
 		var initializedPool miniov2.Pool
 		var poolStatus []miniov2.PoolStatus
 		for _, pstatus := range tenant.Status.Pools {
@@ -103,16 +111,67 @@ func (c *Controller) checkForPoolDecommission(ctx context.Context, key string, t
 				}
 			}
 			if !found {
-				poolNamesRemoved = append(poolNamesRemoved, pstatus.SSName)
+				removedPools = append(removedPools, *pstatus.DeepCopy())
 			} else {
 				poolStatus = append(poolStatus, *pstatus.DeepCopy())
 			}
 		}
+
+		// Pools holding data are drained by MinIO before they are removed, pools that never
+		// got initialized hold no data and can be removed right away.
+		var decomErr error
+		if start := poolsToDecommission(removedPools); len(start) > 0 {
+			decomErr = c.startPoolDecommission(ctx, tenant, start, tenantConfiguration)
+		}
+		var draining bool
+		for i := range removedPools {
+			pstatus := &removedPools[i]
+			if decomErr == nil && pstatus.Decommission != nil &&
+				(pstatus.State == miniov2.PoolDecommissioning || pstatus.State == miniov2.PoolDecommissionFailed) {
+				decomErr = c.decommissionPool(ctx, tenant, pstatus, tenantConfiguration)
+			}
+			switch pstatus.State {
+			case miniov2.PoolInitialized, miniov2.PoolDecommissioning, miniov2.PoolDecommissionFailed:
+				draining = true
+			}
+		}
+		if draining || decomErr != nil {
+			// keep the pools being drained at the end of the status, so the status of the pools in
+			// the spec keeps matching their position, the progress is saved even if a pool failed
+			tenant.Status.Pools = append(poolStatus, removedPools...)
+			if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+				klog.Infof("'%s' Can't update tenant status: %v", key, err)
+				return nil, err
+			}
+			if decomErr != nil {
+				return nil, decomErr
+			}
+			return tenant, nil
+		}
+		if len(removedPools) > 0 && initializedPool.Name != "" {
+			var allowed bool
+			if tenant, allowed, err = c.checkMaintenanceWindow(ctx, key, tenant, miniov2.MaintenancePoolRestart, fmt.Sprintf("Restart pool %s to remove %d pools", initializedPool.Name, len(removedPools))); err != nil {
+				return nil, err
+			}
+			if !allowed {
+				return tenant, nil
+			}
+		}
+		// persist the removal first, so the MinIO arguments no longer include the drained pools when MinIO restarts
 		tenant.Status.Pools = poolStatus
+		if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+			klog.Infof("'%s' Can't update tenant status: %v", key, err)
+			return nil, err
+		}
+
+		err = c.DeletePDB(ctx, tenant)
+		if err != nil {
+			return nil, err
+		}
 
 		var restarted bool
 		// Only restart if there is an initialized pool to fetch the new args.
-		if len(poolNamesRemoved) > 0 && initializedPool.Name != "" {
+		if len(removedPools) > 0 && initializedPool.Name != "" {
 			// Restart services to get new args since we are shrinking the deployment here.
 			if err := c.restartInitializedPool(ctx, tenant, initializedPool, tenantConfiguration); err != nil {
 				return nil, err
@@ -120,6 +179,7 @@ func (c *Controller) checkForPoolDecommission(ctx context.Context, key string, t
 			metaNowTime := metav1.Now()
 			tenant.Status.WaitingOnReady = &metaNowTime
 			tenant.Status.CurrentState = StatusRestartingMinIO
+			setTenantConditions(tenant, tenantConditionsForState(StatusRestartingMinIO)...)
 			if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
 				klog.Infof("'%s' Can't update tenant status: %v", key, err)
 				return nil, err
@@ -128,9 +188,14 @@ func (c *Controller) checkForPoolDecommission(ctx context.Context, key string, t
 			restarted = true
 		}
 
-		for _, ssName := range poolNamesRemoved {
-			c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolRemoved", fmt.Sprintf("Tenant pool %s removed", ssName))
-			if err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Delete(ctx, ssName, metav1.DeleteOptions{}); err != nil {
+		for _, pstatus := range removedPools {
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolRemoved", fmt.Sprintf("Tenant pool %s removed", pstatus.SSName))
+			if tenant.DeletesPVCsWhenPoolRemoved() {
+				if err = c.deletePoolPVCs(ctx, tenant, pstatus.SSName); err != nil {
+					return nil, err
+				}
+			}
+			if err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Delete(ctx, pstatus.SSName, metav1.DeleteOptions{}); err != nil {
 				if k8serrors.IsNotFound(err) {
 					continue
 				}
@@ -146,3 +211,241 @@ func (c *Controller) checkForPoolDecommission(ctx context.Context, key string, t
 	}
 	return tenant, err
 }
+
+// poolsToDecommission returns the removed pools whose decommission has to be started. MinIO drains one set of pools
+// at a time, so pools removed while others are still draining wait for them to finish.
+func poolsToDecommission(removedPools []miniov2.PoolStatus) []*miniov2.PoolStatus {
+	var start []*miniov2.PoolStatus
+	for i := range removedPools {
+		switch removedPools[i].State {
+		case miniov2.PoolDecommissioning, miniov2.PoolDecommissionFailed:
+			if removedPools[i].Decommission != nil {
+				return nil
+			}
+			start = append(start, &removedPools[i])
+		case miniov2.PoolInitialized:
+			start = append(start, &removedPools[i])
+		}
+	}
+	return start
+}
+
+// startPoolDecommission starts the decommission of all the given pools removed from the spec in a single call
+func (c *Controller) startPoolDecommission(ctx context.Context, tenant *miniov2.Tenant, pools []*miniov2.PoolStatus, tenantConfiguration map[string][]byte) error {
+	adminClnt, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+	if err != nil {
+		return err
+	}
+	poolsStatus, err := adminClnt.ListPoolsStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list the pools of the tenant: %w", err)
+	}
+	minioPools := make([]*madmin.PoolStatus, len(pools))
+	var cmdLines, names []string
+	for i, pstatus := range pools {
+		endpoint, err := c.removedPoolEndpoint(tenant, pstatus.SSName)
+		if err != nil {
+			return err
+		}
+		minioPools[i] = findMinIOPool(poolsStatus, endpoint)
+		if minioPools[i] == nil {
+			return fmt.Errorf("unable to find pool %s in MinIO", pstatus.SSName)
+		}
+		// a pool already being drained by MinIO is only tracked
+		if minioPools[i].Decommission == nil || minioPools[i].Decommission.Canceled || minioPools[i].Decommission.Failed {
+			cmdLines = append(cmdLines, minioPools[i].CmdLine)
+		}
+		names = append(names, pstatus.SSName)
+	}
+	if len(cmdLines) > 0 {
+		if err = adminClnt.DecommissionPool(ctx, strings.Join(cmdLines, ",")); err != nil {
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "PoolDecommissionFailed", fmt.Sprintf("Decommission of pools %s failed to start: %s", strings.Join(names, ", "), err))
+			return err
+		}
+	}
+	for i, pstatus := range pools {
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolDecommissionStarted", fmt.Sprintf("Decommission of pool %s started", pstatus.SSName))
+		pstatus.State = miniov2.PoolDecommissioning
+		pstatus.Decommission = &miniov2.PoolDecommissionStatus{
+			Index:   int32(minioPools[i].ID),
+			CmdLine: minioPools[i].CmdLine,
+		}
+	}
+	return nil
+}
+
+// decommissionPool refreshes the progress of a decommission already running. Once MinIO finishes draining the pool
+// it's marked as decommissioned, a decommission stopped by MinIO is restarted with a backoff.
+func (c *Controller) decommissionPool(ctx context.Context, tenant *miniov2.Tenant, pstatus *miniov2.PoolStatus, tenantConfiguration map[string][]byte) error {
+	adminClnt, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+	if err != nil {
+		return err
+	}
+
+	minioPool, err := adminClnt.StatusPool(ctx, pstatus.Decommission.CmdLine)
+	if err != nil {
+		return fmt.Errorf("unable to get the decommission status of pool %s: %w", pstatus.SSName, err)
+	}
+	if minioPool.Decommission == nil {
+		return nil
+	}
+	setPoolDecommissionStatus(pstatus.Decommission, minioPool.Decommission)
+
+	switch {
+	case minioPool.Decommission.Complete:
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolDecommissioned", fmt.Sprintf("Decommission of pool %s completed", pstatus.SSName))
+		pstatus.State = miniov2.PoolDecommissioned
+		pstatus.Decommission.NextRetryTime = nil
+	case minioPool.Decommission.Failed, minioPool.Decommission.Canceled:
+		now := time.Now()
+		if pstatus.State != miniov2.PoolDecommissionFailed {
+			pstatus.State = miniov2.PoolDecommissionFailed
+			next := metav1.NewTime(now.Add(retryBackoff(pstatus.Decommission.Retries)))
+			pstatus.Decommission.NextRetryTime = &next
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "PoolDecommissionFailed", fmt.Sprintf("Decommission of pool %s stopped, retrying at %s", pstatus.SSName, next.UTC().Format(time.RFC3339)))
+			return nil
+		}
+		if pstatus.Decommission.NextRetryTime != nil && now.Before(pstatus.Decommission.NextRetryTime.Time) {
+			return nil
+		}
+		// the pool is no longer in the spec, so the decommission is resumed
+		pstatus.Decommission.Retries++
+		if err = adminClnt.DecommissionPool(ctx, pstatus.Decommission.CmdLine); err != nil {
+			next := metav1.NewTime(now.Add(retryBackoff(pstatus.Decommission.Retries)))
+			pstatus.Decommission.NextRetryTime = &next
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "PoolDecommissionFailed", fmt.Sprintf("Decommission of pool %s failed to restart, retrying at %s: %s", pstatus.SSName, next.UTC().Format(time.RFC3339), err))
+			return nil
+		}
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolDecommissionResumed", fmt.Sprintf("Decommission of pool %s restarted", pstatus.SSName))
+		pstatus.State = miniov2.PoolDecommissioning
+		pstatus.Decommission.NextRetryTime = nil
+	default:
+		pstatus.State = miniov2.PoolDecommissioning
+		pstatus.Decommission.NextRetryTime = nil
+	}
+	return nil
+}
+
+// retryBackoff returns how long to wait before restarting a decommission or rebalance stopped by MinIO, doubling
+// with every retry up to an hour
+func retryBackoff(retries int32) time.Duration {
+	backoff := time.Minute
+	for i := int32(0); i < retries && backoff < time.Hour; i++ {
+		backoff *= 2
+	}
+	if backoff > time.Hour {
+		backoff = time.Hour
+	}
+	return backoff
+}
+
+// removedPoolEndpoint returns the endpoint the MinIO arguments used for a pool removed from the spec, the pool is
+// rebuilt from its statefulset
+func (c *Controller) removedPoolEndpoint(tenant *miniov2.Tenant, ssName string) (string, error) {
+	ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(ssName)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the statefulset of pool %s: %w", ssName, err)
+	}
+	pool := miniov2.Pool{Name: strings.TrimPrefix(ssName, tenant.Name+"-"), Servers: 1}
+	if ss.Spec.Replicas != nil {
+		pool.Servers = *ss.Spec.Replicas
+	}
+	removed := tenant.DeepCopy()
+	removed.Spec.Pools = []miniov2.Pool{pool}
+	endpoints := removed.MinIOEndpoints(c.hostsTemplate)
+	if len(endpoints) == 0 {
+		return "", fmt.Errorf("unable to generate the endpoint of pool %s", ssName)
+	}
+	return endpoints[0], nil
+}
+
+// cancelPoolDecommission cancels the decommission of the pools that were added back to the spec before
+// MinIO finished draining them
+func (c *Controller) cancelPoolDecommission(ctx context.Context, key string, tenant *miniov2.Tenant, tenantConfiguration map[string][]byte) (*miniov2.Tenant, error) {
+	var canceled bool
+	for i := range tenant.Status.Pools {
+		pstatus := &tenant.Status.Pools[i]
+		if pstatus.State != miniov2.PoolDecommissioning && pstatus.State != miniov2.PoolDecommissionFailed {
+			continue
+		}
+		var found bool
+		for _, pool := range tenant.Spec.Pools {
+			if pool.Name != "" && pstatus.SSName == tenant.PoolStatefulsetName(&pool) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		// MinIO is no longer draining a pool whose decommission failed
+		if pstatus.Decommission != nil && pstatus.State == miniov2.PoolDecommissioning {
+			adminClnt, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+			if err != nil {
+				return nil, err
+			}
+			if err = adminClnt.CancelDecommissionPool(ctx, pstatus.Decommission.CmdLine); err != nil {
+				c.recorder.Event(tenant, corev1.EventTypeWarning, "PoolDecommissionCancelFailed", fmt.Sprintf("Decommission of pool %s failed to cancel: %s", pstatus.SSName, err))
+				return nil, err
+			}
+		}
+		klog.Infof("%s Canceled the decommission of pool %s", key, pstatus.SSName)
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolDecommissionCanceled", fmt.Sprintf("Decommission of pool %s canceled", pstatus.SSName))
+		pstatus.State = miniov2.PoolInitialized
+		pstatus.Decommission = nil
+		canceled = true
+	}
+	if !canceled {
+		return tenant, nil
+	}
+	sortPoolStatusBySpec(tenant)
+	return c.updatePoolStatus(ctx, tenant)
+}
+
+// findMinIOPool returns the pool of MinIO whose argument starts with the given endpoint
+func findMinIOPool(poolsStatus []madmin.PoolStatus, endpoint string) *madmin.PoolStatus {
+	for i := range poolsStatus {
+		if strings.HasPrefix(poolsStatus[i].CmdLine, endpoint+"/") {
+			return &poolsStatus[i]
+		}
+	}
+	return nil
+}
+
+// setPoolDecommissionStatus copies the decommission progress reported by MinIO into the pool status
+func setPoolDecommissionStatus(status *miniov2.PoolDecommissionStatus, info *madmin.PoolDecommissionInfo) {
+	if !info.StartTime.IsZero() {
+		startTime := metav1.NewTime(info.StartTime)
+		status.StartTime = &startTime
+	}
+	status.StartSize = info.StartSize
+	status.TotalSize = info.TotalSize
+	status.CurrentSize = info.CurrentSize
+	status.ObjectsDecommissioned = info.ObjectsDecommissioned
+	status.ObjectsDecommissionFailed = info.ObjectsDecommissionFailed
+	status.BytesDecommissioned = info.BytesDone
+	status.BytesDecommissionFailed = info.BytesFailed
+	status.Complete = info.Complete
+	status.Failed = info.Failed
+	status.Canceled = info.Canceled
+}
+
+// sortPoolStatusBySpec orders the status of the pools following the order of the pools in the spec,
+// pools not present in the spec are kept at the end
+func sortPoolStatusBySpec(tenant *miniov2.Tenant) {
+	position := map[string]int{}
+	for i, pool := range tenant.Spec.Pools {
+		position[tenant.PoolStatefulsetName(&pool)] = i
+	}
+	sort.SliceStable(tenant.Status.Pools, func(i, j int) bool {
+		pi, ok := position[tenant.Status.Pools[i].SSName]
+		if !ok {
+			pi = len(tenant.Spec.Pools)
+		}
+		pj, ok := position[tenant.Status.Pools[j].SSName]
+		if !ok {
+			pj = len(tenant.Spec.Pools)
+		}
+		return pi < pj
+	})
+}
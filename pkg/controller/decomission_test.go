@@ -0,0 +1,254 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_findMinIOPool(t *testing.T) {
+	pools := []madmin.PoolStatus{
+		{ID: 0, CmdLine: "https://tenant-pool-1-a-{0...3}.tenant-hl.ns.svc.cluster.local/export{0...3}"},
+		{ID: 1, CmdLine: "https://tenant-pool-1-{0...3}.tenant-hl.ns.svc.cluster.local/export{0...3}"},
+		{ID: 2, CmdLine: "https://minio-tenant-pool-2-{0...3}.example.com/export{0...3}"},
+	}
+	tests := []struct {
+		endpoint string
+		wantID   int
+	}{
+		{endpoint: "https://tenant-pool-1-{0...3}.tenant-hl.ns.svc.cluster.local", wantID: 1},
+		{endpoint: "https://tenant-pool-1-a-{0...3}.tenant-hl.ns.svc.cluster.local", wantID: 0},
+		// endpoints generated from a custom hosts template
+		{endpoint: "https://minio-tenant-pool-2-{0...3}.example.com", wantID: 2},
+		{endpoint: "https://tenant-pool-1-{0...1}.tenant-hl.ns.svc.cluster.local", wantID: -1},
+	}
+	for _, tt := range tests {
+		pool := findMinIOPool(pools, tt.endpoint)
+		switch {
+		case pool == nil && tt.wantID >= 0:
+			t.Errorf("findMinIOPool(%s) = nil, want pool %d", tt.endpoint, tt.wantID)
+		case pool != nil && pool.ID != tt.wantID:
+			t.Errorf("findMinIOPool(%s) = pool %d, want pool %d", tt.endpoint, pool.ID, tt.wantID)
+		}
+	}
+}
+
+func Test_retryBackoff(t *testing.T) {
+	for retries, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute} {
+		if got := retryBackoff(int32(retries)); got != want {
+			t.Errorf("retryBackoff(%d) = %s, want %s", retries, got, want)
+		}
+	}
+	if got := retryBackoff(20); got != time.Hour {
+		t.Errorf("retryBackoff(20) = %s, want 1h", got)
+	}
+}
+
+func Test_poolsToDecommission(t *testing.T) {
+	status := func(name string, state miniov2.PoolState, started bool) miniov2.PoolStatus {
+		pstatus := miniov2.PoolStatus{SSName: name, State: state}
+		if started {
+			pstatus.Decommission = &miniov2.PoolDecommissionStatus{}
+		}
+		return pstatus
+	}
+	tests := []struct {
+		name    string
+		removed []miniov2.PoolStatus
+		want    []string
+	}{
+		{
+			name:    "All removed pools start together",
+			removed: []miniov2.PoolStatus{status("pool-1", miniov2.PoolInitialized, false), status("pool-2", miniov2.PoolInitialized, false)},
+			want:    []string{"pool-1", "pool-2"},
+		},
+		{
+			name:    "Pools never initialized hold no data",
+			removed: []miniov2.PoolStatus{status("pool-1", miniov2.PoolCreated, false), status("pool-2", miniov2.PoolInitialized, false)},
+			want:    []string{"pool-2"},
+		},
+		{
+			name:    "Removed pools wait for the running decommission",
+			removed: []miniov2.PoolStatus{status("pool-1", miniov2.PoolDecommissioning, true), status("pool-2", miniov2.PoolInitialized, false)},
+		},
+		{
+			name:    "Removed pools wait for the failed decommission to be retried",
+			removed: []miniov2.PoolStatus{status("pool-1", miniov2.PoolDecommissionFailed, true), status("pool-2", miniov2.PoolInitialized, false)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, pstatus := range poolsToDecommission(tt.removed) {
+				got = append(got, pstatus.SSName)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("poolsToDecommission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// decommissionTenant returns a tenant with pool-0 in the spec and the given pools removed from it
+func decommissionTenant(removed ...miniov2.PoolStatus) *miniov2.Tenant {
+	autoCert := false
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns"},
+		Spec: miniov2.TenantSpec{
+			RequestAutoCert: &autoCert,
+			Pools:           []miniov2.Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+		},
+	}
+	tenant.Status.Pools = append([]miniov2.PoolStatus{{SSName: "tenant-pool-0", State: miniov2.PoolInitialized}}, removed...)
+	return tenant
+}
+
+func TestCheckForPoolDecommission(t *testing.T) {
+	tests := []struct {
+		name            string
+		removed         []miniov2.PoolStatus
+		decommissionErr bool
+		wantCalls       []string
+		wantStates      []miniov2.PoolState
+		wantErr         bool
+	}{
+		{
+			name: "Two removed pools start in one call",
+			removed: []miniov2.PoolStatus{
+				{SSName: "tenant-pool-1", State: miniov2.PoolInitialized},
+				{SSName: "tenant-pool-2", State: miniov2.PoolInitialized},
+			},
+			wantCalls:  []string{"pool-1,pool-2"},
+			wantStates: []miniov2.PoolState{miniov2.PoolDecommissioning, miniov2.PoolDecommissioning},
+		},
+		{
+			name: "A pool removed while another drains waits",
+			removed: []miniov2.PoolStatus{
+				{SSName: "tenant-pool-1", State: miniov2.PoolDecommissioning, Decommission: &miniov2.PoolDecommissionStatus{Index: 1, CmdLine: "pool-1"}},
+				{SSName: "tenant-pool-2", State: miniov2.PoolInitialized},
+			},
+			wantStates: []miniov2.PoolState{miniov2.PoolDecommissioning, miniov2.PoolInitialized},
+		},
+		{
+			name: "Status is kept when the decommission fails to start",
+			removed: []miniov2.PoolStatus{
+				{SSName: "tenant-pool-1", State: miniov2.PoolInitialized},
+				{SSName: "tenant-pool-2", State: miniov2.PoolInitialized},
+			},
+			decommissionErr: true,
+			wantCalls:       []string{"pool-1,pool-2"},
+			wantStates:      []miniov2.PoolState{miniov2.PoolInitialized, miniov2.PoolInitialized},
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := decommissionTenant(tt.removed...)
+			// the command line of each pool in MinIO, named after the pool to keep the assertions short
+			cmdLines := map[string]string{}
+			var poolsStatus []madmin.PoolStatus
+			for i, name := range []string{"pool-0", "pool-1", "pool-2"} {
+				endpoint := tenant.DeepCopy()
+				endpoint.Spec.Pools = []miniov2.Pool{{Name: name, Servers: 4}}
+				cmdLine := endpoint.MinIOEndpoints("")[0] + "/export{0...3}"
+				cmdLines[cmdLine] = name
+				poolsStatus = append(poolsStatus, madmin.PoolStatus{ID: i, CmdLine: cmdLine})
+			}
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/pools/list"):
+					json.NewEncoder(w).Encode(poolsStatus)
+				case strings.HasSuffix(r.URL.Path, "/pools/decommission"):
+					var names []string
+					for _, cmdLine := range strings.Split(r.URL.Query().Get("pool"), ",") {
+						names = append(names, cmdLines[cmdLine])
+					}
+					calls = append(calls, strings.Join(names, ","))
+					if tt.decommissionErr {
+						w.WriteHeader(http.StatusInternalServerError)
+					}
+				case strings.HasSuffix(r.URL.Path, "/pools/status"):
+					json.NewEncoder(w).Encode(madmin.PoolStatus{
+						CmdLine:      r.URL.Query().Get("pool"),
+						Decommission: &madmin.PoolDecommissionInfo{StartTime: time.Now(), CurrentSize: 42},
+					})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, name := range []string{"tenant-pool-1", "tenant-pool-2"} {
+				replicas := int32(4)
+				indexer.Add(&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+					Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+				})
+			}
+			c := &Controller{
+				minioClientSet:    fake.NewSimpleClientset(tenant.DeepCopy()),
+				statefulSetLister: appslisters.NewStatefulSetLister(indexer),
+				recorder:          record.NewFakeRecorder(100),
+				transport: &http.Transport{
+					DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+						return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+					},
+				},
+			}
+			tenantConfiguration := map[string][]byte{"accesskey": []byte("minio"), "secretkey": []byte("minio123")}
+			_, err := c.checkForPoolDecommission(context.Background(), "ns/tenant", tenant, tenantConfiguration)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkForPoolDecommission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if strings.Join(calls, " ") != strings.Join(tt.wantCalls, " ") {
+				t.Errorf("decommission calls = %v, want %v", calls, tt.wantCalls)
+			}
+			stored, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), "tenant", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stored.Status.Pools) != len(tt.wantStates)+1 {
+				t.Fatalf("stored %d pools, want %d", len(stored.Status.Pools), len(tt.wantStates)+1)
+			}
+			for i, want := range tt.wantStates {
+				pstatus := stored.Status.Pools[i+1]
+				if pstatus.State != want {
+					t.Errorf("pool %s state = %s, want %s", pstatus.SSName, pstatus.State, want)
+				}
+				if pstatus.State == miniov2.PoolDecommissioning && (pstatus.Decommission == nil || pstatus.Decommission.CurrentSize != 42) {
+					t.Errorf("pool %s progress wasn't saved: %+v", pstatus.SSName, pstatus.Decommission)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// driveFailed checks if a drive is in a state that replacing its volume can fix. Unformatted drives are fresh drives
+// MinIO heals on its own
+func driveFailed(state string) bool {
+	return state != madmin.DriveStateOk && state != madmin.DriveStateUnformatted
+}
+
+// replaceFailedDrives tracks the drives MinIO reports as failed, and once a drive has been offline for longer than the
+// grace period it deletes its PersistentVolumeClaim and restarts its pod, so the StatefulSet provisions a new volume.
+// The new drives are healed, and their progress is recorded in the tenant status.
+func (c *Controller) replaceFailedDrives(ctx context.Context, tenant *miniov2.Tenant, adminClnt *madmin.AdminClient, storageInfo madmin.StorageInfo) {
+	disks := storageInfo.Disks
+	if !tenant.HasDriveReplacement() {
+		tenant.Status.DriveReplacements = nil
+		return
+	}
+
+	// heal the replaced drives once they are back online
+	states := map[string]string{}
+	for _, disk := range disks {
+		states[disk.Endpoint] = disk.State
+	}
+	healStarted := false
+	for _, replacement := range tenant.Status.DriveReplacements {
+		if replacement.State != miniov2.DriveReplacementReplacing || states[replacement.Endpoint] != madmin.DriveStateOk {
+			continue
+		}
+		if !healStarted {
+			hctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			_, _, err := adminClnt.Heal(hctx, "", "", madmin.HealOpts{Recursive: true, ScanMode: madmin.HealNormalScan}, "", false, false)
+			cancel()
+			if err != nil {
+				// MinIO also heals new drives in the background
+				klog.Infof("'%s/%s' Failed to start healing: %v", tenant.Namespace, tenant.Name, err)
+			}
+			healStarted = true
+		}
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "DriveHealing", fmt.Sprintf("Healing drive %s", replacement.Endpoint))
+	}
+
+	replacements, healed := trackDriveReplacements(tenant.Status.DriveReplacements, disks, metav1.Now())
+	for _, replacement := range healed {
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "DriveHealed", fmt.Sprintf("Drive %s healed, %d items healed, %d failed", replacement.Endpoint, replacement.ItemsHealed, replacement.ItemsFailed))
+	}
+	tenant.Status.DriveReplacements = replacements
+
+	// restart one pod at a time
+	for _, replacement := range replacements {
+		if replacement.State == miniov2.DriveReplacementReplacing {
+			return
+		}
+	}
+
+	byEndpoint := map[string]madmin.Disk{}
+	for _, disk := range disks {
+		byEndpoint[disk.Endpoint] = disk
+	}
+	type erasureSet struct{ pool, set int }
+	// a single drive of an erasure set is replaced at a time, it has to heal before the next one
+	replacedSets := map[erasureSet]bool{}
+
+	var pod *corev1.Pod
+	gracePeriod := tenant.DriveReplacementGracePeriod()
+	for i := range replacements {
+		replacement := &replacements[i]
+		if replacement.State != miniov2.DriveReplacementOffline || time.Since(replacement.OfflineSince.Time) < gracePeriod {
+			continue
+		}
+		podName, drivePath, err := driveEndpointPod(replacement.Endpoint)
+		if err != nil {
+			klog.Infof("'%s/%s' %v", tenant.Namespace, tenant.Name, err)
+			continue
+		}
+		disk, ok := byEndpoint[replacement.Endpoint]
+		if !ok {
+			continue
+		}
+		set := erasureSet{pool: disk.PoolIndex, set: disk.SetIndex}
+		if replacedSets[set] {
+			continue
+		}
+		// when no drive of the pod is online, MinIO can't reach the pod rather than its drives
+		if !podHasOnlineDrive(disks, podName) {
+			continue
+		}
+		if pod == nil {
+			p, err := c.kubeClientSet.CoreV1().Pods(tenant.Namespace).Get(ctx, podName, metav1.GetOptions{})
+			if err != nil {
+				klog.Infof("'%s/%s' Can't get pod of drive %s: %v", tenant.Namespace, tenant.Name, replacement.Endpoint, err)
+				continue
+			}
+			// a drive of a pod that isn't ready is offline because of the pod, not the drive
+			if p.Labels[miniov2.TenantLabel] != tenant.Name || !minioContainerReady(p) || p.Status.StartTime == nil {
+				continue
+			}
+			// the drive must have been offline for the whole grace period while the pod was running
+			if time.Since(p.Status.StartTime.Time) < gracePeriod {
+				continue
+			}
+			// all the drives of the pod go offline while it restarts
+			if err = podRestartKeepsQuorum(storageInfo, podName); err != nil {
+				klog.Infof("'%s/%s' Not replacing drive %s: %v", tenant.Namespace, tenant.Name, replacement.Endpoint, err)
+				continue
+			}
+			pod = p
+		} else if pod.Name != podName {
+			continue
+		}
+
+		pvcName := drivePVC(pod, drivePath)
+		if pvcName == "" {
+			klog.Infof("'%s/%s' Can't find the PersistentVolumeClaim of drive %s", tenant.Namespace, tenant.Name, replacement.Endpoint)
+			continue
+		}
+		if err = c.kubeClientSet.CoreV1().PersistentVolumeClaims(tenant.Namespace).Delete(ctx, pvcName, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			klog.Infof("'%s/%s' Failed to delete PersistentVolumeClaim %s: %v", tenant.Namespace, tenant.Name, pvcName, err)
+			continue
+		}
+		replacedSets[set] = true
+		now := metav1.Now()
+		replacement.State = miniov2.DriveReplacementReplacing
+		replacement.Pod = pod.Name
+		replacement.PersistentVolumeClaim = pvcName
+		replacement.ReplaceTime = &now
+		c.recorder.Event(tenant, corev1.EventTypeWarning, "DriveReplaced", fmt.Sprintf("Drive %s offline since %s, replacing PersistentVolumeClaim %s",
+			replacement.Endpoint, replacement.OfflineSince.Format(time.RFC3339), pvcName))
+	}
+	if pod == nil {
+		return
+	}
+	// the PersistentVolumeClaims are only removed once the pod is gone, the StatefulSet recreates both
+	for _, replacement := range replacements {
+		if replacement.Pod == pod.Name && replacement.State == miniov2.DriveReplacementReplacing {
+			klog.Infof("'%s/%s' Restarting pod %s to replace its failed drives", tenant.Namespace, tenant.Name, pod.Name)
+			if err := c.kubeClientSet.CoreV1().Pods(tenant.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				klog.Infof("'%s/%s' Failed to restart pod %s: %v", tenant.Namespace, tenant.Name, pod.Name, err)
+			}
+			return
+		}
+	}
+}
+
+// minioContainerReady checks if the MinIO container of the pod passes its readiness probe, a pod can be running with
+// its MinIO container crashing
+func minioContainerReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == miniov2.MinIOServerName {
+			return status.Ready
+		}
+	}
+	return false
+}
+
+// podHasOnlineDrive checks if MinIO reports at least one drive of the pod as online
+func podHasOnlineDrive(disks []madmin.Disk, podName string) bool {
+	for _, disk := range disks {
+		if drivePod, _, err := driveEndpointPod(disk.Endpoint); err == nil && drivePod == podName && disk.State == madmin.DriveStateOk {
+			return true
+		}
+	}
+	return false
+}
+
+// podRestartKeepsQuorum checks that every erasure set with a drive on the pod keeps its write quorum with the drives
+// that are online on the other pods, as reported by MinIO
+func podRestartKeepsQuorum(storageInfo madmin.StorageInfo, podName string) error {
+	type erasureSet struct{ pool, set int }
+	drives := map[erasureSet]int{}
+	online := map[erasureSet]int{}
+	affected := map[erasureSet]bool{}
+	for _, disk := range storageInfo.Disks {
+		set := erasureSet{pool: disk.PoolIndex, set: disk.SetIndex}
+		drivePod, _, err := driveEndpointPod(disk.Endpoint)
+		if err != nil || set.pool < 0 || set.set < 0 {
+			return fmt.Errorf("MinIO doesn't report the erasure set of drive %s", disk.Endpoint)
+		}
+		drives[set]++
+		if drivePod == podName {
+			affected[set] = true
+		} else if disk.State == madmin.DriveStateOk && !disk.Healing {
+			// a drive still healing doesn't hold all of its data yet
+			online[set]++
+		}
+	}
+	if len(affected) == 0 {
+		return fmt.Errorf("MinIO doesn't report any drive of pod %s", podName)
+	}
+	backend := storageInfo.Backend
+	for set := range affected {
+		parity := backend.StandardSCParity
+		if set.pool < len(backend.StandardSCParities) {
+			parity = backend.StandardSCParities[set.pool]
+		}
+		// MinIO needs one more drive to write when there are as many data drives as parity drives
+		quorum := drives[set] - parity
+		if quorum == parity {
+			quorum++
+		}
+		if online[set] < quorum {
+			return fmt.Errorf("erasure set %d of pool %d would have %d drives online without pod %s, its write quorum is %d",
+				set.set, set.pool, online[set], podName, quorum)
+		}
+	}
+	return nil
+}
+
+// trackDriveReplacements updates the replacements with the drives reported by MinIO: failed drives are tracked as
+// offline, drives back online before being replaced are dropped, and the healing progress of the new drives is
+// recorded. It also returns the replacements whose drive finished healing.
+func trackDriveReplacements(replacements []miniov2.DriveReplacementStatus, disks []madmin.Disk, now metav1.Time) ([]miniov2.DriveReplacementStatus, []miniov2.DriveReplacementStatus) {
+	byEndpoint := map[string]madmin.Disk{}
+	for _, disk := range disks {
+		byEndpoint[disk.Endpoint] = disk
+	}
+
+	var tracked, healed []miniov2.DriveReplacementStatus
+	seen := map[string]bool{}
+	for _, replacement := range replacements {
+		disk, ok := byEndpoint[replacement.Endpoint]
+		seen[replacement.Endpoint] = true
+		switch replacement.State {
+		case miniov2.DriveReplacementOffline:
+			if !ok || !driveFailed(disk.State) {
+				// the drive is back before it was replaced
+				continue
+			}
+		case miniov2.DriveReplacementReplacing:
+			if ok && disk.State == madmin.DriveStateOk {
+				replacement.State = miniov2.DriveReplacementHealing
+				setDriveHealProgress(&replacement, disk)
+			}
+		case miniov2.DriveReplacementHealing:
+			if !ok {
+				break
+			}
+			if driveFailed(disk.State) {
+				// the new drive failed as well
+				replacement = miniov2.DriveReplacementStatus{
+					Endpoint:     replacement.Endpoint,
+					State:        miniov2.DriveReplacementOffline,
+					OfflineSince: now,
+				}
+				break
+			}
+			if !disk.Healing {
+				healed = append(healed, replacement)
+				continue
+			}
+			setDriveHealProgress(&replacement, disk)
+		}
+		tracked = append(tracked, replacement)
+	}
+
+	for _, disk := range disks {
+		if seen[disk.Endpoint] || !driveFailed(disk.State) {
+			continue
+		}
+		tracked = append(tracked, miniov2.DriveReplacementStatus{
+			Endpoint:     disk.Endpoint,
+			State:        miniov2.DriveReplacementOffline,
+			OfflineSince: now,
+		})
+	}
+	sort.Slice(tracked, func(i, j int) bool {
+		return tracked[i].Endpoint < tracked[j].Endpoint
+	})
+	return tracked, healed
+}
+
+// setDriveHealProgress records the progress MinIO reports healing the drive
+func setDriveHealProgress(replacement *miniov2.DriveReplacementStatus, disk madmin.Disk) {
+	if disk.HealInfo == nil {
+		return
+	}
+	replacement.ObjectsTotalCount = safeToInt64(disk.HealInfo.ObjectsTotalCount)
+	replacement.ItemsHealed = safeToInt64(disk.HealInfo.ItemsHealed)
+	replacement.ItemsFailed = safeToInt64(disk.HealInfo.ItemsFailed)
+	replacement.BytesDone = safeToInt64(disk.HealInfo.BytesDone)
+}
+
+// driveEndpointPod returns the pod and the path of a drive endpoint, MinIO pods are addressed by their hostname
+// under the headless service
+func driveEndpointPod(endpoint string) (string, string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Hostname() == "" || u.Path == "" {
+		return "", "", fmt.Errorf("drive endpoint %s has no host or path", endpoint)
+	}
+	return strings.SplitN(u.Hostname(), ".", 2)[0], u.Path, nil
+}
+
+// drivePVC returns the PersistentVolumeClaim mounted by the MinIO container at the path of the drive
+func drivePVC(pod *corev1.Pod, drivePath string) string {
+	var volumeName string
+	for _, container := range pod.Spec.Containers {
+		if container.Name != miniov2.MinIOServerName {
+			continue
+		}
+		for _, mount := range container.VolumeMounts {
+			if drivePath == mount.MountPath || strings.HasPrefix(drivePath, strings.TrimSuffix(mount.MountPath, "/")+"/") {
+				volumeName = mount.Name
+			}
+		}
+	}
+	if volumeName == "" {
+		return ""
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == volumeName && volume.PersistentVolumeClaim != nil {
+			return volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	return ""
+}
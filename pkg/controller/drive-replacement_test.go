@@ -0,0 +1,369 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+const testDriveEndpoint = "https://myminio-pool-0-1.myminio-hl.tenant-ns.svc.cluster.local:9000/export2"
+
+func TestTrackDriveReplacements(t *testing.T) {
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	tests := []struct {
+		name         string
+		replacements []miniov2.DriveReplacementStatus
+		disk         madmin.Disk
+		wantState    miniov2.DriveReplacementState
+		wantSince    metav1.Time
+		wantTracked  bool
+		wantHealed   bool
+	}{
+		{
+			name:        "New offline drive",
+			disk:        madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateFaulty},
+			wantState:   miniov2.DriveReplacementOffline,
+			wantSince:   now,
+			wantTracked: true,
+		},
+		{
+			name:         "Drive still offline",
+			replacements: []miniov2.DriveReplacementStatus{{Endpoint: testDriveEndpoint, State: miniov2.DriveReplacementOffline, OfflineSince: earlier}},
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateOffline},
+			wantState:    miniov2.DriveReplacementOffline,
+			wantSince:    earlier,
+			wantTracked:  true,
+		},
+		{
+			name:         "Drive back online before being replaced",
+			replacements: []miniov2.DriveReplacementStatus{{Endpoint: testDriveEndpoint, State: miniov2.DriveReplacementOffline, OfflineSince: earlier}},
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateOk},
+		},
+		{
+			name:         "Unformatted drives are healed by MinIO",
+			replacements: nil,
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateUnformatted},
+		},
+		{
+			name:         "Replaced drive online",
+			replacements: []miniov2.DriveReplacementStatus{{Endpoint: testDriveEndpoint, State: miniov2.DriveReplacementReplacing, OfflineSince: earlier}},
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateOk, Healing: true, HealInfo: &madmin.HealingDisk{ItemsHealed: 10}},
+			wantState:    miniov2.DriveReplacementHealing,
+			wantSince:    earlier,
+			wantTracked:  true,
+		},
+		{
+			name:         "Replaced drive not online yet",
+			replacements: []miniov2.DriveReplacementStatus{{Endpoint: testDriveEndpoint, State: miniov2.DriveReplacementReplacing, OfflineSince: earlier}},
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateOffline},
+			wantState:    miniov2.DriveReplacementReplacing,
+			wantSince:    earlier,
+			wantTracked:  true,
+		},
+		{
+			name:         "Drive healed",
+			replacements: []miniov2.DriveReplacementStatus{{Endpoint: testDriveEndpoint, State: miniov2.DriveReplacementHealing, OfflineSince: earlier}},
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateOk},
+			wantHealed:   true,
+		},
+		{
+			name:         "New drive failed",
+			replacements: []miniov2.DriveReplacementStatus{{Endpoint: testDriveEndpoint, State: miniov2.DriveReplacementHealing, OfflineSince: earlier}},
+			disk:         madmin.Disk{Endpoint: testDriveEndpoint, State: madmin.DriveStateFaulty},
+			wantState:    miniov2.DriveReplacementOffline,
+			wantSince:    now,
+			wantTracked:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracked, healed := trackDriveReplacements(tt.replacements, []madmin.Disk{tt.disk}, now)
+			if (len(healed) == 1) != tt.wantHealed {
+				t.Errorf("trackDriveReplacements() healed = %v, want healed %v", healed, tt.wantHealed)
+			}
+			if (len(tracked) == 1) != tt.wantTracked {
+				t.Fatalf("trackDriveReplacements() tracked = %v, want tracked %v", tracked, tt.wantTracked)
+			}
+			if !tt.wantTracked {
+				return
+			}
+			if tracked[0].State != tt.wantState {
+				t.Errorf("trackDriveReplacements() state = %v, want %v", tracked[0].State, tt.wantState)
+			}
+			if !tracked[0].OfflineSince.Equal(&tt.wantSince) {
+				t.Errorf("trackDriveReplacements() offlineSince = %v, want %v", tracked[0].OfflineSince, tt.wantSince)
+			}
+		})
+	}
+}
+
+func TestDrivePVC(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: miniov2.MinIOServerName,
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "configuration", MountPath: "/tmp/minio-config"},
+						{Name: "data0", MountPath: "/export0"},
+						{Name: "data1", MountPath: "/export1"},
+						{Name: "data2", MountPath: "/export2"},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{Name: "data0", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data0-myminio-pool-0-1"}}},
+				{Name: "data1", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data1-myminio-pool-0-1"}}},
+				{Name: "data2", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data2-myminio-pool-0-1"}}},
+			},
+		},
+	}
+
+	podName, drivePath, err := driveEndpointPod(testDriveEndpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if podName != "myminio-pool-0-1" {
+		t.Errorf("driveEndpointPod() pod = %s, want myminio-pool-0-1", podName)
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: drivePath, want: "data2-myminio-pool-0-1"},
+		{path: "/export1/data", want: "data1-myminio-pool-0-1"},
+		{path: "/export10", want: ""},
+		{path: "/tmp/minio-config", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := drivePVC(pod, tt.path); got != tt.want {
+				t.Errorf("drivePVC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodRestartKeepsQuorum(t *testing.T) {
+	// a pool of 4 pods with 2 drives each in a single erasure set of 8 drives, EC:4 needs 5 drives to write
+	disks := func(offline ...string) []madmin.Disk {
+		var disks []madmin.Disk
+		for pod := 0; pod < 4; pod++ {
+			for drive := 0; drive < 2; drive++ {
+				endpoint := fmt.Sprintf("https://myminio-pool-0-%d.myminio-hl.tenant-ns.svc.cluster.local:9000/export%d", pod, drive)
+				disk := madmin.Disk{Endpoint: endpoint, State: madmin.DriveStateOk, DiskIndex: pod*2 + drive}
+				for _, o := range offline {
+					if o == endpoint {
+						disk.State = madmin.DriveStateOffline
+					}
+				}
+				disks = append(disks, disk)
+			}
+		}
+		return disks
+	}
+	drive := func(pod, drive int) string {
+		return fmt.Sprintf("https://myminio-pool-0-%d.myminio-hl.tenant-ns.svc.cluster.local:9000/export%d", pod, drive)
+	}
+	backend := madmin.BackendInfo{StandardSCParities: []int{4}, DrivesPerSet: []int{8}, TotalSets: []int{1}}
+
+	tests := []struct {
+		name    string
+		disks   []madmin.Disk
+		backend madmin.BackendInfo
+		wantErr bool
+	}{
+		{
+			name:    "Only the drive of the pod is offline",
+			disks:   disks(drive(0, 1)),
+			backend: backend,
+		},
+		{
+			name:    "Another pod lost a drive",
+			disks:   disks(drive(0, 1), drive(1, 0)),
+			backend: backend,
+		},
+		{
+			name:    "Another pod is offline",
+			disks:   disks(drive(0, 1), drive(1, 0), drive(1, 1)),
+			backend: backend,
+			wantErr: true,
+		},
+		{
+			name: "Another pod is healing",
+			disks: func() []madmin.Disk {
+				healing := disks(drive(0, 1), drive(1, 0))
+				healing[3].Healing = true
+				return healing
+			}(),
+			backend: backend,
+			wantErr: true,
+		},
+		{
+			name:    "EC:2 needs more drives online",
+			disks:   disks(drive(0, 1), drive(1, 0)),
+			backend: madmin.BackendInfo{StandardSCParity: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := podRestartKeepsQuorum(madmin.StorageInfo{Disks: tt.disks, Backend: tt.backend}, "myminio-pool-0-0")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("podRestartKeepsQuorum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// driveReplacementPod returns a running pod of the tenant with two drives, and the claims of its drives
+func driveReplacementPod(name string, ready bool) []runtime.Object {
+	started := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "tenant-ns", Labels: map[string]string{miniov2.TenantLabel: "myminio"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: miniov2.MinIOServerName,
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "data0", MountPath: "/export0"},
+						{Name: "data1", MountPath: "/export1"},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			StartTime:         &started,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: miniov2.MinIOServerName, Ready: ready}},
+		},
+	}
+	objects := []runtime.Object{pod}
+	for _, volume := range []string{"data0", "data1"} {
+		claim := fmt.Sprintf("%s-%s", volume, name)
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name:         volume,
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim}},
+		})
+		objects = append(objects, &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: claim, Namespace: "tenant-ns"}})
+	}
+	return objects
+}
+
+func TestReplaceFailedDrives(t *testing.T) {
+	drive := func(pod, drive int) string {
+		return fmt.Sprintf("https://myminio-pool-0-%d.myminio-hl.tenant-ns.svc.cluster.local:9000/export%d", pod, drive)
+	}
+	// a pool of 4 pods with 2 drives each in a single erasure set of 8 drives
+	storageInfo := func(offline ...string) madmin.StorageInfo {
+		info := madmin.StorageInfo{Backend: madmin.BackendInfo{StandardSCParities: []int{4}}}
+		for pod := 0; pod < 4; pod++ {
+			for d := 0; d < 2; d++ {
+				disk := madmin.Disk{Endpoint: drive(pod, d), State: madmin.DriveStateOk, DiskIndex: pod*2 + d}
+				for _, o := range offline {
+					if o == disk.Endpoint {
+						disk.State = madmin.DriveStateOffline
+					}
+				}
+				info.Disks = append(info.Disks, disk)
+			}
+		}
+		return info
+	}
+
+	tests := []struct {
+		name         string
+		offline      []string
+		ready        bool
+		wantReplaced []string
+	}{
+		{
+			name:         "A drive of a ready pod",
+			offline:      []string{drive(0, 1)},
+			ready:        true,
+			wantReplaced: []string{"data1-myminio-pool-0-0"},
+		},
+		{
+			name:    "All the drives of one pod offline",
+			offline: []string{drive(0, 0), drive(0, 1)},
+			ready:   true,
+		},
+		{
+			name:    "MinIO container of the pod not ready",
+			offline: []string{drive(0, 1)},
+			ready:   false,
+		},
+		{
+			name:         "One drive per erasure set",
+			offline:      []string{drive(0, 1), drive(1, 1)},
+			ready:        true,
+			wantReplaced: []string{"data1-myminio-pool-0-0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []runtime.Object
+			for pod := 0; pod < 4; pod++ {
+				objects = append(objects, driveReplacementPod(fmt.Sprintf("myminio-pool-0-%d", pod), tt.ready)...)
+			}
+			kubeClient := fake.NewSimpleClientset(objects...)
+			c := &Controller{kubeClientSet: kubeClient, recorder: record.NewFakeRecorder(100)}
+
+			offlineSince := metav1.NewTime(time.Now().Add(-time.Hour))
+			tenant := &miniov2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "myminio", Namespace: "tenant-ns"},
+				Spec:       miniov2.TenantSpec{DriveReplacement: &miniov2.DriveReplacement{Enabled: true}},
+			}
+			for _, endpoint := range tt.offline {
+				tenant.Status.DriveReplacements = append(tenant.Status.DriveReplacements, miniov2.DriveReplacementStatus{
+					Endpoint:     endpoint,
+					State:        miniov2.DriveReplacementOffline,
+					OfflineSince: offlineSince,
+				})
+			}
+			c.replaceFailedDrives(context.Background(), tenant, nil, storageInfo(tt.offline...))
+
+			claims, err := kubeClient.CoreV1().PersistentVolumeClaims("tenant-ns").List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			remaining := map[string]bool{}
+			for _, claim := range claims.Items {
+				remaining[claim.Name] = true
+			}
+			if deleted := 8 - len(claims.Items); deleted != len(tt.wantReplaced) {
+				t.Errorf("replaceFailedDrives() deleted %d claims, want %v", deleted, tt.wantReplaced)
+			}
+			for _, claim := range tt.wantReplaced {
+				if remaining[claim] {
+					t.Errorf("replaceFailedDrives() kept claim %s", claim)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ensureTenantFinalizer adds the finalizer that lets the operator clean up after the tenant before it's gone
+func (c *Controller) ensureTenantFinalizer(ctx context.Context, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	if controllerutil.ContainsFinalizer(tenant, miniov2.TenantFinalizer) {
+		return tenant, nil
+	}
+	t, err := c.patchTenantFinalizers(ctx, tenant, append(tenant.Finalizers, miniov2.TenantFinalizer))
+	if err != nil {
+		return nil, err
+	}
+	t.EnsureDefaults()
+	return t, nil
+}
+
+// patchTenantFinalizers replaces the finalizers of the tenant with a metadata patch, so the spec isn't sent back to
+// the API server. The resource version makes the patch fail if the tenant changed in the meantime.
+func (c *Controller) patchTenantFinalizers(ctx context.Context, tenant *miniov2.Tenant, finalizers []string) (*miniov2.Tenant, error) {
+	if finalizers == nil {
+		finalizers = []string{}
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      finalizers,
+			"resourceVersion": tenant.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Patch(ctx, tenant.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+}
+
+// finalizeTenant removes the resources of a deleted tenant that owner references don't take care of: cluster
+// scoped resources, the Prometheus scrape config and, depending on the retention policy, the PersistentVolumeClaims
+func (c *Controller) finalizeTenant(ctx context.Context, key string, tenant *miniov2.Tenant) error {
+	if !controllerutil.ContainsFinalizer(tenant, miniov2.TenantFinalizer) {
+		return nil
+	}
+	if err := c.deletePrometheusAddlConfig(ctx, tenant); err != nil {
+		return err
+	}
+	for _, csrName := range []string{tenant.MinIOCSRName(), tenant.MinIOClientCSRName(), tenant.KESCSRName()} {
+		if err := c.deleteCSR(ctx, csrName); err != nil {
+			return err
+		}
+	}
+	if tenant.DeletesPVCsWhenDeleted() {
+		klog.Infof("'%s' Deleting the PersistentVolumeClaims of the tenant", key)
+		if err := c.kubeClientSet.CoreV1().PersistentVolumeClaims(tenant.Namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", miniov2.TenantLabel, tenant.Name),
+		}); err != nil {
+			return err
+		}
+	}
+
+	var finalizers []string
+	for _, f := range tenant.Finalizers {
+		if f != miniov2.TenantFinalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	if _, err := c.patchTenantFinalizers(ctx, tenant, finalizers); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	klog.Infof("'%s' Tenant resources cleaned up", key)
+	return nil
+}
+
+// deletePoolPVCs deletes the PersistentVolumeClaims of the pods of a pool StatefulSet
+func (c *Controller) deletePoolPVCs(ctx context.Context, tenant *miniov2.Tenant, ssName string) error {
+	ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(ssName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// the selector of the statefulset is passed down to its PersistentVolumeClaims
+	selector, err := metav1.LabelSelectorAsSelector(ss.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	return c.kubeClientSet.CoreV1().PersistentVolumeClaims(tenant.Namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/set"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// groupAPI is the part of the MinIO admin client used to sync a group
+type groupAPI interface {
+	policyAttachmentAPI
+	GetGroupDescription(ctx context.Context, group string) (*madmin.GroupDesc, error)
+	UpdateGroupMembers(ctx context.Context, g madmin.GroupAddRemove) error
+	SetGroupStatus(ctx context.Context, group string, status madmin.GroupStatus) error
+}
+
+// enqueueGroup takes a MinIOGroup resource and puts its namespace/name key onto the group queue
+func (c *Controller) enqueueGroup(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	if !c.namespacesToWatch.IsEmpty() {
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			runtime.HandleError(err)
+			return
+		}
+		if !c.namespacesToWatch.Contains(object.GetNamespace()) {
+			klog.Infof("Ignoring group `%s` in namespace that is not watched by this controller.", key)
+			return
+		}
+	}
+	c.groupQueue.Add(key)
+}
+
+// runGroupWorker processes the MinIOGroups of the group queue
+func (c *Controller) runGroupWorker() {
+	defer runtime.HandleCrash()
+	for processNextItem(c.groupQueue, c.syncGroupHandler) {
+	}
+}
+
+// syncGroupHandler creates or updates the group of a MinIOGroup resource in its tenant
+func (c *Controller) syncGroupHandler(key string) (Result, error) {
+	ctx := context.Background()
+	namespace, name := key2NamespaceName(key)
+	group, err := c.groupLister.MinIOGroups(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, nil)
+		}
+		return WrapResult(Result{}, err)
+	}
+	group = group.DeepCopy()
+
+	tenant, err := c.minioClientSet.MinioV2().Tenants(namespace).Get(ctx, group.Spec.Tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, err)
+		}
+		tenant = nil
+	}
+
+	if group.DeletionTimestamp != nil {
+		return WrapResult(Result{}, c.finalizeGroup(ctx, key, group, tenant))
+	}
+	if !controllerutil.ContainsFinalizer(group, iamv1alpha1.IAMFinalizer) {
+		controllerutil.AddFinalizer(group, iamv1alpha1.IAMFinalizer)
+		// the update queues the group again
+		_, err = c.minioClientSet.IamV1alpha1().MinIOGroups(namespace).Update(ctx, group, metav1.UpdateOptions{})
+		return WrapResult(Result{}, err)
+	}
+
+	if err = group.Validate(); err != nil {
+		return WrapResult(Result{}, c.updateGroupReady(ctx, group, metav1.ConditionFalse, "InvalidSpec", err.Error()))
+	}
+	if tenant == nil {
+		msg := fmt.Sprintf("Tenant %s not found", group.Spec.Tenant.Name)
+		return WrapResult(Result{RequeueAfter: 30 * time.Second}, c.updateGroupReady(ctx, group, metav1.ConditionFalse, "TenantNotFound", msg))
+	}
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		msg := fmt.Sprintf("Waiting for tenant %s to be healthy", tenant.Name)
+		return WrapResult(Result{RequeueAfter: 10 * time.Second}, c.updateGroupReady(ctx, group, metav1.ConditionFalse, "TenantNotReady", msg))
+	}
+
+	adminClient, err := c.newTenantAdminClient(ctx, tenant)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+	changed, err := syncGroup(ctx, adminClient, group)
+	if err != nil {
+		klog.Errorf("'%s' Failed to sync group %s: %v", key, group.GroupName(), err)
+		c.recorder.Event(group, corev1.EventTypeWarning, "GroupSyncFailed", err.Error())
+		if uerr := c.updateGroupReady(ctx, group, metav1.ConditionFalse, "SyncFailed", err.Error()); uerr != nil {
+			return WrapResult(Result{}, uerr)
+		}
+		return WrapResult(Result{}, err)
+	}
+
+	// the generation was already synced, so the group changed in MinIO
+	if len(changed) > 0 && group.Status.ObservedGeneration == group.Generation {
+		msg := fmt.Sprintf("Restored the settings changed outside of the MinIOGroup resource: %s", strings.Join(changed, ", "))
+		klog.Infof("'%s' %s", key, msg)
+		c.recorder.Event(group, corev1.EventTypeWarning, "GroupDrift", msg)
+		now := metav1.Now()
+		group.Status.LastDriftTime = &now
+	} else if len(changed) > 0 {
+		c.recorder.Event(group, corev1.EventTypeNormal, "GroupSynced", fmt.Sprintf("Group %s synced: %s", group.GroupName(), strings.Join(changed, ", ")))
+	}
+	group.Status.Name = group.GroupName()
+	group.Status.ObservedGeneration = group.Generation
+	if err = c.updateGroupReady(ctx, group, metav1.ConditionTrue, "GroupSynced", "The group matches its spec"); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	// the group can be changed outside of the operator, check it again from time to time
+	return WrapResult(Result{RequeueAfter: iamResyncPeriod}, nil)
+}
+
+// finalizeGroup removes the group of a deleted MinIOGroup from its tenant
+func (c *Controller) finalizeGroup(ctx context.Context, key string, group *iamv1alpha1.MinIOGroup, tenant *miniov2.Tenant) error {
+	if !controllerutil.ContainsFinalizer(group, iamv1alpha1.IAMFinalizer) {
+		return nil
+	}
+	// the group is gone along with a deleted tenant
+	if group.Status.Name != "" && tenant != nil && tenant.DeletionTimestamp == nil {
+		adminClient, err := c.newTenantAdminClient(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if err = removeGroup(ctx, adminClient, group.Status.Name); err != nil {
+			return err
+		}
+		klog.Infof("'%s' Removed group %s", key, group.Status.Name)
+	}
+	controllerutil.RemoveFinalizer(group, iamv1alpha1.IAMFinalizer)
+	if _, err := c.minioClientSet.IamV1alpha1().MinIOGroups(group.Namespace).Update(ctx, group, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// updateGroupReady sets the Ready condition of the group and persists its status if it changed
+func (c *Controller) updateGroupReady(ctx context.Context, group *iamv1alpha1.MinIOGroup, status metav1.ConditionStatus, reason, msg string) error {
+	current, err := c.groupLister.MinIOGroups(group.Namespace).Get(group.Name)
+	if err != nil {
+		return err
+	}
+	updated := group.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+		Type:               iamv1alpha1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: group.Generation,
+	})
+	if equality.Semantic.DeepEqual(current.Status, updated.Status) {
+		return nil
+	}
+	_, err = c.minioClientSet.IamV1alpha1().MinIOGroups(group.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// syncGroup creates the group in MinIO and applies its members, status and policies. Returns the settings that were
+// changed.
+func syncGroup(ctx context.Context, api groupAPI, group *iamv1alpha1.MinIOGroup) ([]string, error) {
+	name := group.GroupName()
+	status := madmin.GroupEnabled
+	if group.Spec.Disabled {
+		status = madmin.GroupDisabled
+	}
+	var changed []string
+
+	desc, err := api.GetGroupDescription(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchGroup" {
+		return nil, err
+	}
+	if err != nil {
+		// MinIO creates the group along with its members
+		if err = api.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: group.Spec.Members, Status: madmin.GroupEnabled}); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "group")
+		desc = &madmin.GroupDesc{Name: name, Status: string(madmin.GroupEnabled), Members: group.Spec.Members}
+	}
+
+	current := set.CreateStringSet(desc.Members...)
+	wanted := set.CreateStringSet(group.Spec.Members...)
+	toAdd := wanted.Difference(current).ToSlice()
+	toRemove := current.Difference(wanted).ToSlice()
+	if len(toAdd) > 0 {
+		if err = api.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: toAdd}); err != nil {
+			return nil, err
+		}
+	}
+	// removing no members removes the group itself
+	if len(toRemove) > 0 {
+		if err = api.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: toRemove, IsRemove: true}); err != nil {
+			return nil, err
+		}
+	}
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		changed = append(changed, "members")
+	}
+
+	if desc.Status != string(status) {
+		if err = api.SetGroupStatus(ctx, name, status); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "status")
+	}
+
+	policiesChanged, err := syncAttachedPolicies(ctx, api, desc.Policy, group.Spec.Policies, "", name)
+	if err != nil {
+		return nil, err
+	}
+	if policiesChanged {
+		changed = append(changed, "policies")
+	}
+	return changed, nil
+}
+
+// removeGroup removes the members of the group from MinIO and then the group, a group that is already gone isn't an
+// error
+func removeGroup(ctx context.Context, api groupAPI, name string) error {
+	desc, err := api.GetGroupDescription(ctx, name)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchGroup" {
+			return nil
+		}
+		return err
+	}
+	// MinIO only removes empty groups
+	if len(desc.Members) > 0 {
+		if err = api.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: desc.Members, IsRemove: true}); err != nil {
+			return err
+		}
+	}
+	return api.UpdateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, IsRemove: true})
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/set"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (f *fakeIAMAPI) GetGroupDescription(_ context.Context, group string) (*madmin.GroupDesc, error) {
+	desc, ok := f.groups[group]
+	if !ok {
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchGroup"}
+	}
+	result := *desc
+	result.Policy = f.attached("group:" + group)
+	return &result, nil
+}
+
+func (f *fakeIAMAPI) UpdateGroupMembers(_ context.Context, g madmin.GroupAddRemove) error {
+	desc, ok := f.groups[g.Group]
+	if !ok {
+		desc = &madmin.GroupDesc{Name: g.Group, Status: string(madmin.GroupEnabled)}
+		f.groups[g.Group] = desc
+	}
+	members := set.CreateStringSet(desc.Members...)
+	if g.IsRemove && len(g.Members) == 0 {
+		if !members.IsEmpty() {
+			return madmin.ErrorResponse{Code: "XMinioAdminGroupNotEmpty"}
+		}
+		delete(f.groups, g.Group)
+		return nil
+	}
+	for _, member := range g.Members {
+		if g.IsRemove {
+			members.Remove(member)
+		} else {
+			members.Add(member)
+		}
+	}
+	desc.Members = members.ToSlice()
+	sort.Strings(desc.Members)
+	return nil
+}
+
+func (f *fakeIAMAPI) SetGroupStatus(_ context.Context, group string, status madmin.GroupStatus) error {
+	f.groups[group].Status = string(status)
+	return nil
+}
+
+func TestSyncGroup(t *testing.T) {
+	group := &iamv1alpha1.MinIOGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "analysts"},
+		Spec: iamv1alpha1.MinIOGroupSpec{
+			Tenant:   iamv1alpha1.TenantReference{Name: "myminio"},
+			Members:  []string{"alice", "bob"},
+			Policies: []string{"read-data"},
+		},
+	}
+	api := newFakeIAMAPI()
+	ctx := context.Background()
+
+	changed, err := syncGroup(ctx, api, group)
+	if err != nil {
+		t.Fatalf("syncGroup() error = %v", err)
+	}
+	if want := []string{"group", "policies"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncGroup() changed = %v, want %v", changed, want)
+	}
+
+	if changed, err = syncGroup(ctx, api, group); err != nil || len(changed) != 0 {
+		t.Errorf("syncGroup() of a group in sync changed = %v, error = %v", changed, err)
+	}
+
+	// members and status changed outside of the MinIOGroup resource are restored
+	api.groups["analysts"].Members = []string{"alice", "mallory"}
+	api.groups["analysts"].Status = string(madmin.GroupDisabled)
+	changed, err = syncGroup(ctx, api, group)
+	if err != nil {
+		t.Fatalf("syncGroup() error = %v", err)
+	}
+	if want := []string{"members", "status"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncGroup() changed = %v, want %v", changed, want)
+	}
+	if desc := api.groups["analysts"]; !reflect.DeepEqual(desc.Members, []string{"alice", "bob"}) || desc.Status != string(madmin.GroupEnabled) {
+		t.Errorf("syncGroup() didn't restore the group: %+v", desc)
+	}
+
+	if err = removeGroup(ctx, api, "analysts"); err != nil || len(api.groups) != 0 {
+		t.Errorf("removeGroup() = %v, groups %v", err, api.groups)
+	}
+	if err = removeGroup(ctx, api, "analysts"); err != nil {
+		t.Errorf("removeGroup() of a missing group = %v", err)
+	}
+}
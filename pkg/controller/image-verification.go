@@ -0,0 +1,164 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/signature"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// verifyTenantImage verifies the signatures of the image the tenant is being upgraded to and records the result
+// in the tenant status. The digest that was verified is recorded too, so the pools run the image pinned to it. When
+// the MinIO binary is verified with minisign the artifacts stay in place and the release tag is returned, so they
+// don't have to be fetched again for an in-place update.
+func (c *Controller) verifyTenantImage(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, string, error) {
+	if !tenant.HasImageVerification() {
+		return tenant, "", nil
+	}
+	image := tenant.Spec.Image
+	latest, digest, verifyErr := c.verifyImageSignatures(ctx, tenant, image)
+
+	now := metav1.Now()
+	status := &miniov2.ImageVerificationStatus{
+		Image:    image,
+		Verified: verifyErr == nil,
+		Digest:   digest,
+		Message:  fmt.Sprintf("Image %s verified", image),
+		Time:     &now,
+	}
+	if verifyErr != nil {
+		status.Message = fmt.Sprintf("Image %s failed verification: %v", image, verifyErr)
+	}
+	tenant.Status.ImageVerification = status
+	tenant, err := c.updatePoolStatus(ctx, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	if verifyErr != nil {
+		klog.Warningf("'%s' %s", key, status.Message)
+		c.recorder.Event(tenant, corev1.EventTypeWarning, "ImageVerificationFailed", status.Message)
+		return tenant, "", errors.New(status.Message)
+	}
+	klog.Infof("'%s' %s", key, status.Message)
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "ImageVerified", status.Message)
+	return tenant, latest, nil
+}
+
+// verifyImageSignatures resolves the digest of the image, then checks the cosign signatures of that digest and the
+// minisign signature of its MinIO binary. Returns the release tag of the fetched binary, if any, and the digest.
+func (c *Controller) verifyImageSignatures(ctx context.Context, tenant *miniov2.Tenant, image string) (string, string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", "", err
+	}
+	options := []remote.Option{remote.WithContext(ctx), remote.WithAuthFromKeychain(c.tenantKeychain(ctx, ref, tenant))}
+	desc, err := remote.Head(ref, options...)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to resolve the image digest: %w", err)
+	}
+	// everything is verified against the digest, the tag could be moved in the meantime
+	pinned := ref.Context().Digest(desc.Digest.String())
+
+	verification := tenant.Spec.ImageVerification
+	if len(verification.CosignPublicKeys) > 0 || verification.CosignKeyless != nil {
+		verifier, err := newCosignVerifier(verification)
+		if err != nil {
+			return "", "", err
+		}
+		if err = verifier.Verify(pinned, options...); err != nil {
+			return "", "", err
+		}
+	}
+
+	if verification.MinisignPublicKey == "" {
+		return "", desc.Digest.String(), nil
+	}
+	publicKey, err := signature.ParseMinisignPublicKey(verification.MinisignPublicKey)
+	if err != nil {
+		return "", "", err
+	}
+	latest, err := c.fetchArtifacts(tenant, pinned.Name())
+	if err != nil {
+		return "", "", err
+	}
+	binary, err := os.ReadFile(filepath.Join(updatePath, "minio."+latest))
+	if err != nil {
+		return "", "", err
+	}
+	sig, err := os.ReadFile(filepath.Join(updatePath, "minio."+latest+".minisig"))
+	if err != nil {
+		return "", "", err
+	}
+	if err = publicKey.Verify(binary, sig); err != nil {
+		return "", "", err
+	}
+	return latest, desc.Digest.String(), nil
+}
+
+// imageTag returns the tag of the image, leaving out the registry port and the digest it may be pinned to
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return ""
+}
+
+// newCosignVerifier builds the verifier of the cosign signatures accepted by the tenant
+func newCosignVerifier(verification *miniov2.ImageVerification) (*signature.CosignVerifier, error) {
+	verifier := &signature.CosignVerifier{}
+	for _, key := range verification.CosignPublicKeys {
+		publicKey, err := signature.ParsePublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cosign public key: %w", err)
+		}
+		verifier.PublicKeys = append(verifier.PublicKeys, publicKey)
+	}
+	keyless := verification.CosignKeyless
+	if keyless == nil {
+		return verifier, nil
+	}
+	roots, err := signature.ParseCertificates([]byte(keyless.RootCertificates))
+	if err != nil {
+		return nil, fmt.Errorf("invalid cosign root certificates: %w", err)
+	}
+	verifier.Roots = x509.NewCertPool()
+	for _, root := range roots {
+		verifier.Roots.AddCert(root)
+	}
+	if verifier.TransparencyLogKey, err = signature.ParsePublicKey(keyless.TransparencyLogPublicKey); err != nil {
+		return nil, fmt.Errorf("invalid transparency log public key: %w", err)
+	}
+	for _, identity := range keyless.Identities {
+		verifier.Identities = append(verifier.Identities, signature.KeylessIdentity{
+			Issuer:  identity.Issuer,
+			Subject: identity.Subject,
+		})
+	}
+	return verifier, nil
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/minio/operator/pkg/resources/statefulsets"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 
 	"k8s.io/klog/v2"
 
@@ -270,7 +271,22 @@ func (c *Controller) checkKESStatus(ctx context.Context, tenant *miniov2.Tenant,
 					return err
 				}
 				c.recorder.Event(tenant, corev1.EventTypeNormal, "StsUpdated", "KES Statefulset Updated")
+				return nil
 			}
+
+			kesReady := newTenantCondition(miniov2.TenantConditionKESReady, metav1.ConditionTrue, KESReadyReason, "All KES replicas are ready")
+			if existingStatefulSet.Status.ReadyReplicas < tenant.KESReplicas() || existingStatefulSet.Status.UpdatedReplicas < tenant.KESReplicas() {
+				kesReady = newTenantCondition(miniov2.TenantConditionKESReady, metav1.ConditionFalse, KESNotReadyReason,
+					fmt.Sprintf("%d of %d KES replicas are ready", existingStatefulSet.Status.ReadyReplicas, tenant.KESReplicas()))
+			}
+			if _, err = c.updateTenantConditions(ctx, tenant, kesReady); err != nil {
+				return err
+			}
+		}
+	} else if meta.FindStatusCondition(tenant.Status.Conditions, miniov2.TenantConditionKESReady) != nil {
+		kesDisabled := newTenantCondition(miniov2.TenantConditionKESReady, metav1.ConditionFalse, KESDisabledReason, "KES is not enabled")
+		if _, err := c.updateTenantConditions(ctx, tenant, kesDisabled); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -0,0 +1,260 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// storageInfoAPI is the part of the MinIO admin client used to check the erasure sets before drives are released
+type storageInfoAPI interface {
+	StorageInfo(ctx context.Context) (madmin.StorageInfo, error)
+}
+
+// recoverPodsOnLostNodes detects the pods of the tenant that are pending because their local volumes are pinned to a
+// node that is gone or NotReady. Once a pod has been stuck for longer than the timeout, its PersistentVolumeClaims on
+// the lost node and the pod are deleted, so the StatefulSet recreates them on another node and MinIO heals the drives.
+// Pods are released one at a time, and only while the erasure sets of their drives keep quorum without them.
+func (c *Controller) recoverPodsOnLostNodes(ctx context.Context, tenant *miniov2.Tenant, api storageInfoAPI) (*miniov2.Tenant, error) {
+	if !tenant.HasLostNodeRecovery() {
+		if len(tenant.Status.LostNodeRecoveries) == 0 {
+			return tenant, nil
+		}
+		tenant.Status.LostNodeRecoveries = nil
+		return c.updatePoolStatus(ctx, tenant)
+	}
+
+	pods, err := c.kubeClientSet.CoreV1().Pods(tenant.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", miniov2.TenantLabel, tenant.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	previous := map[string]miniov2.LostNodeRecoveryStatus{}
+	for _, recovery := range tenant.Status.LostNodeRecoveries {
+		previous[recovery.Pod] = recovery
+	}
+
+	var recoveries []miniov2.LostNodeRecoveryStatus
+	seen := map[string]bool{}
+	for _, pod := range pods.Items {
+		seen[pod.Name] = true
+		recovery, tracked := previous[pod.Name]
+		if tracked && recovery.State == miniov2.LostNodeRecoveryReleased {
+			if pod.Status.Phase == corev1.PodRunning {
+				c.recorder.Event(tenant, corev1.EventTypeNormal, "PodRecovered", fmt.Sprintf("Pod %s is running again, MinIO heals its new drives", pod.Name))
+				continue
+			}
+			// the pod is being recreated with new volumes
+			recoveries = append(recoveries, recovery)
+		}
+	}
+	// a released pod that isn't running yet has no drives online, wait for it before releasing another one
+	releasing := len(recoveries) > 0
+	for _, recovery := range tenant.Status.LostNodeRecoveries {
+		if !seen[recovery.Pod] && recovery.State == miniov2.LostNodeRecoveryReleased {
+			releasing = true
+		}
+	}
+	var storageInfo *madmin.StorageInfo
+	for _, pod := range pods.Items {
+		recovery, tracked := previous[pod.Name]
+		if tracked && recovery.State == miniov2.LostNodeRecoveryReleased {
+			continue
+		}
+		if pod.Status.Phase != corev1.PodPending || pod.DeletionTimestamp != nil {
+			continue
+		}
+		lost, err := podVolumesOnLostNode(ctx, c.kubeClientSet, &pod)
+		if err != nil {
+			klog.Infof("'%s/%s' Can't check the nodes of the volumes of pod %s: %v", tenant.Namespace, tenant.Name, pod.Name, err)
+			if tracked {
+				recoveries = append(recoveries, recovery)
+			}
+			continue
+		}
+		if lost == nil {
+			continue
+		}
+		if !tracked {
+			recovery = *lost
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "PodOnLostNode", fmt.Sprintf("Pod %s is pending, its volumes %s are on node %s that is gone or NotReady",
+				pod.Name, strings.Join(lost.PersistentVolumeClaims, ", "), lost.Node))
+		}
+
+		if time.Since(recovery.Since.Time) >= tenant.LostNodeRecoveryTimeout() && !releasing {
+			if storageInfo == nil {
+				info, err := api.StorageInfo(ctx)
+				if err != nil {
+					klog.Infof("'%s/%s' Can't get the storage info to release the volumes of pod %s: %v", tenant.Namespace, tenant.Name, pod.Name, err)
+					recoveries = append(recoveries, recovery)
+					continue
+				}
+				storageInfo = &info
+			}
+			if err = podRestartKeepsQuorum(*storageInfo, pod.Name); err != nil {
+				klog.Infof("'%s/%s' Not releasing the volumes of pod %s: %v", tenant.Namespace, tenant.Name, pod.Name, err)
+			} else if err = c.releasePodVolumes(ctx, tenant, &pod, &recovery); err != nil {
+				klog.Infof("'%s/%s' Failed to release the volumes of pod %s: %v", tenant.Namespace, tenant.Name, pod.Name, err)
+			} else {
+				releasing = true
+			}
+		}
+		recoveries = append(recoveries, recovery)
+	}
+	// keep track of the released pods being recreated by the StatefulSet
+	for _, recovery := range tenant.Status.LostNodeRecoveries {
+		if !seen[recovery.Pod] && recovery.State == miniov2.LostNodeRecoveryReleased {
+			recoveries = append(recoveries, recovery)
+		}
+	}
+	sort.Slice(recoveries, func(i, j int) bool {
+		return recoveries[i].Pod < recoveries[j].Pod
+	})
+
+	if equality.Semantic.DeepEqual(recoveries, tenant.Status.LostNodeRecoveries) {
+		return tenant, nil
+	}
+	tenant.Status.LostNodeRecoveries = recoveries
+	return c.updatePoolStatus(ctx, tenant)
+}
+
+// releasePodVolumes deletes the PersistentVolumeClaims of a pod on a lost node and the pod, the claims are only
+// removed once the pod is gone
+func (c *Controller) releasePodVolumes(ctx context.Context, tenant *miniov2.Tenant, pod *corev1.Pod, recovery *miniov2.LostNodeRecoveryStatus) error {
+	for _, pvcName := range recovery.PersistentVolumeClaims {
+		if err := c.kubeClientSet.CoreV1().PersistentVolumeClaims(tenant.Namespace).Delete(ctx, pvcName, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		c.recorder.Event(tenant, corev1.EventTypeWarning, "PVCReleased", fmt.Sprintf("PersistentVolumeClaim %s of pod %s on lost node %s deleted", pvcName, pod.Name, recovery.Node))
+	}
+	if err := c.kubeClientSet.CoreV1().Pods(tenant.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "PodRescheduled", fmt.Sprintf("Pod %s deleted to be scheduled away from lost node %s", pod.Name, recovery.Node))
+	now := metav1.Now()
+	recovery.State = miniov2.LostNodeRecoveryReleased
+	recovery.ReleaseTime = &now
+	return nil
+}
+
+// podVolumesOnLostNode returns the lost node the bound volumes of the pod are pinned to, with the claims of those
+// volumes, or nil if none of its volumes is on a lost node
+func podVolumesOnLostNode(ctx context.Context, kubeClientSet kubernetes.Interface, pod *corev1.Pod) (*miniov2.LostNodeRecoveryStatus, error) {
+	var lost *miniov2.LostNodeRecoveryStatus
+	nodes := map[string]*metav1.Time{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		pvc, err := kubeClientSet.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, volume.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if pvc.Spec.VolumeName == "" {
+			continue
+		}
+		pv, err := kubeClientSet.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		hostname := persistentVolumeHostname(pv)
+		if hostname == "" {
+			continue
+		}
+		lostSince, checked := nodes[hostname]
+		if !checked {
+			if lostSince, err = nodeLostSince(ctx, kubeClientSet, hostname, pod.CreationTimestamp); err != nil {
+				return nil, err
+			}
+			nodes[hostname] = lostSince
+		}
+		if lostSince == nil {
+			continue
+		}
+		if lost == nil {
+			lost = &miniov2.LostNodeRecoveryStatus{
+				Pod:   pod.Name,
+				Node:  hostname,
+				State: miniov2.LostNodeRecoveryDetected,
+				Since: *lostSince,
+			}
+		}
+		lost.PersistentVolumeClaims = append(lost.PersistentVolumeClaims, pvc.Name)
+	}
+	return lost, nil
+}
+
+// persistentVolumeHostname returns the hostname a PersistentVolume is pinned to by its node affinity, local volumes
+// are only reachable from that node
+func persistentVolumeHostname(pv *corev1.PersistentVolume) string {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return ""
+	}
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, expression := range term.MatchExpressions {
+			if expression.Key == corev1.LabelHostname && expression.Operator == corev1.NodeSelectorOpIn && len(expression.Values) == 1 {
+				return expression.Values[0]
+			}
+		}
+	}
+	return ""
+}
+
+// nodeLostSince returns since when the node with the hostname is gone or NotReady, or nil if the node is ready. A pod
+// can't be stuck on a lost node before it was created.
+func nodeLostSince(ctx context.Context, kubeClientSet kubernetes.Interface, hostname string, podCreation metav1.Time) (*metav1.Time, error) {
+	nodes, err := kubeClientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", corev1.LabelHostname, hostname),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes.Items) == 0 {
+		return &podCreation, nil
+	}
+	for _, condition := range nodes.Items[0].Status.Conditions {
+		if condition.Type != corev1.NodeReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return nil, nil
+		}
+		if condition.LastTransitionTime.After(podCreation.Time) {
+			return &condition.LastTransitionTime, nil
+		}
+		return &podCreation, nil
+	}
+	return nil, nil
+}
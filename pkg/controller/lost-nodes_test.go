@@ -0,0 +1,268 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	miniofake "github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+// localVolume returns a bound claim and its local volume pinned to the node with the hostname
+func localVolume(name, hostname string) (*corev1.PersistentVolumeClaim, *corev1.PersistentVolume) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "tenant-ns"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-" + name},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-" + name},
+		Spec: corev1.PersistentVolumeSpec{
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: corev1.LabelHostname, Operator: corev1.NodeSelectorOpIn, Values: []string{hostname}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return pvc, pv
+}
+
+// node returns a node with the hostname and the given Ready condition
+func node(hostname string, ready corev1.ConditionStatus, transition time.Time) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: hostname, Labels: map[string]string{corev1.LabelHostname: hostname}},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: ready, LastTransitionTime: metav1.NewTime(transition)},
+			},
+		},
+	}
+}
+
+func TestPodVolumesOnLostNode(t *testing.T) {
+	podCreation := time.Now().Add(-time.Hour).Truncate(time.Second)
+	notReadySince := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "myminio-pool-0-1", Namespace: "tenant-ns", CreationTimestamp: metav1.NewTime(podCreation)},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{Name: "data0", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data0-myminio-pool-0-1"}}},
+				{Name: "data1", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data1-myminio-pool-0-1"}}},
+			},
+		},
+	}
+	pvc0, pv0 := localVolume("data0-myminio-pool-0-1", "node-1")
+	pvc1, pv1 := localVolume("data1-myminio-pool-0-1", "node-1")
+
+	tests := []struct {
+		name      string
+		node      *corev1.Node
+		wantLost  bool
+		wantSince time.Time
+	}{
+		{
+			name:     "Node is ready",
+			node:     node("node-1", corev1.ConditionTrue, podCreation),
+			wantLost: false,
+		},
+		{
+			name:      "Node is gone",
+			wantLost:  true,
+			wantSince: podCreation,
+		},
+		{
+			name:      "Node is NotReady",
+			node:      node("node-1", corev1.ConditionUnknown, notReadySince),
+			wantLost:  true,
+			wantSince: notReadySince,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []runtime.Object{pvc0, pv0, pvc1, pv1}
+			if tt.node != nil {
+				objects = append(objects, tt.node)
+			}
+			lost, err := podVolumesOnLostNode(context.Background(), fake.NewSimpleClientset(objects...), pod)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (lost != nil) != tt.wantLost {
+				t.Fatalf("podVolumesOnLostNode() = %v, want lost %v", lost, tt.wantLost)
+			}
+			if lost == nil {
+				return
+			}
+			if lost.Node != "node-1" || len(lost.PersistentVolumeClaims) != 2 {
+				t.Errorf("podVolumesOnLostNode() = %v, want both claims on node-1", lost)
+			}
+			if !lost.Since.Time.Equal(tt.wantSince) {
+				t.Errorf("podVolumesOnLostNode() since = %v, want %v", lost.Since, tt.wantSince)
+			}
+		})
+	}
+}
+
+// fakeStorageInfoAPI reports a single erasure set of 8 pods with 2 drives each, the drives of the lost pods are
+// offline and the ones of the healing pods are still being healed
+type fakeStorageInfoAPI struct {
+	parity  int
+	lost    map[int]bool
+	healing map[int]bool
+}
+
+func (f *fakeStorageInfoAPI) StorageInfo(_ context.Context) (madmin.StorageInfo, error) {
+	info := madmin.StorageInfo{Backend: madmin.BackendInfo{StandardSCParities: []int{f.parity}}}
+	for pod := 0; pod < 8; pod++ {
+		for d := 0; d < 2; d++ {
+			disk := madmin.Disk{
+				Endpoint:  fmt.Sprintf("https://myminio-pool-0-%d.myminio-hl.tenant-ns.svc.cluster.local:9000/export%d", pod, d),
+				State:     madmin.DriveStateOk,
+				Healing:   f.healing[pod],
+				DiskIndex: pod*2 + d,
+			}
+			if f.lost[pod] {
+				disk.State = madmin.DriveStateOffline
+			}
+			info.Disks = append(info.Disks, disk)
+		}
+	}
+	return info, nil
+}
+
+func TestRecoverPodsOnLostNodes(t *testing.T) {
+	lostSince := time.Now().Add(-time.Hour)
+	// pods on lost nodes, pending with their 2 local volumes pinned to node-<pod>
+	lostPods := func(pods ...int) []runtime.Object {
+		var objects []runtime.Object
+		for _, i := range pods {
+			name := fmt.Sprintf("myminio-pool-0-%d", i)
+			hostname := fmt.Sprintf("node-%d", i)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         "tenant-ns",
+					Labels:            map[string]string{miniov2.TenantLabel: "myminio"},
+					CreationTimestamp: metav1.NewTime(lostSince.Add(-time.Hour)),
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodPending},
+			}
+			for d := 0; d < 2; d++ {
+				pvc, pv := localVolume(fmt.Sprintf("data%d-%s", d, name), hostname)
+				pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+					Name:         fmt.Sprintf("data%d", d),
+					VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name}},
+				})
+				objects = append(objects, pvc, pv)
+			}
+			objects = append(objects, pod, node(hostname, corev1.ConditionUnknown, lostSince))
+		}
+		return objects
+	}
+
+	tests := []struct {
+		name         string
+		parity       int
+		lost         []int
+		healing      []int
+		released     []string
+		wantReleased []string
+	}{
+		{
+			name:         "One pod released when two nodes are lost",
+			parity:       4,
+			lost:         []int{1, 2},
+			wantReleased: []string{"myminio-pool-0-1"},
+		},
+		{
+			name:     "No pod released while another one is recreated",
+			parity:   4,
+			lost:     []int{2},
+			released: []string{"myminio-pool-0-1"},
+		},
+		{
+			name:    "No pod released while the drives of another one heal",
+			parity:  2,
+			lost:    []int{2},
+			healing: []int{1},
+		},
+		{
+			name:         "Pod released once the drives of another one healed",
+			parity:       2,
+			lost:         []int{2},
+			wantReleased: []string{"myminio-pool-0-2"},
+		},
+		{
+			name:   "No pod released when the erasure set would lose quorum",
+			parity: 2,
+			lost:   []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &miniov2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "myminio", Namespace: "tenant-ns"},
+				Spec:       miniov2.TenantSpec{LostNodeRecovery: &miniov2.LostNodeRecovery{Enabled: true}},
+			}
+			for _, pod := range tt.released {
+				tenant.Status.LostNodeRecoveries = append(tenant.Status.LostNodeRecoveries, miniov2.LostNodeRecoveryStatus{
+					Pod:   pod,
+					State: miniov2.LostNodeRecoveryReleased,
+				})
+			}
+			api := &fakeStorageInfoAPI{parity: tt.parity, lost: map[int]bool{}, healing: map[int]bool{}}
+			for _, pod := range tt.lost {
+				api.lost[pod] = true
+			}
+			for _, pod := range tt.healing {
+				api.healing[pod] = true
+			}
+			c := &Controller{
+				kubeClientSet:  fake.NewSimpleClientset(lostPods(tt.lost...)...),
+				minioClientSet: miniofake.NewSimpleClientset(tenant.DeepCopy()),
+				recorder:       record.NewFakeRecorder(100),
+			}
+			if _, err := c.recoverPodsOnLostNodes(context.Background(), tenant, api); err != nil {
+				t.Fatalf("recoverPodsOnLostNodes() error = %v", err)
+			}
+			var released []string
+			for _, i := range tt.lost {
+				name := fmt.Sprintf("myminio-pool-0-%d", i)
+				if _, err := c.kubeClientSet.CoreV1().Pods("tenant-ns").Get(context.Background(), name, metav1.GetOptions{}); err != nil {
+					released = append(released, name)
+				}
+			}
+			if fmt.Sprint(released) != fmt.Sprint(tt.wantReleased) {
+				t.Errorf("recoverPodsOnLostNodes() released %v, want %v", released, tt.wantReleased)
+			}
+		})
+	}
+}
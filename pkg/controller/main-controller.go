@@ -70,12 +70,18 @@ import (
 	"k8s.io/client-go/tools/record"
 	queue "k8s.io/client-go/util/workqueue"
 
+	bucketv1alpha1 "github.com/minio/operator/pkg/apis/bucket.min.io/v1alpha1"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	clientset "github.com/minio/operator/pkg/client/clientset/versioned"
 	minioscheme "github.com/minio/operator/pkg/client/clientset/versioned/scheme"
+	bucketinformers "github.com/minio/operator/pkg/client/informers/externalversions/bucket.min.io/v1alpha1"
+	iaminformers "github.com/minio/operator/pkg/client/informers/externalversions/iam.min.io/v1alpha1"
 	jobinformers "github.com/minio/operator/pkg/client/informers/externalversions/job.min.io/v1alpha1"
 	informers "github.com/minio/operator/pkg/client/informers/externalversions/minio.min.io/v2"
 	stsInformers "github.com/minio/operator/pkg/client/informers/externalversions/sts.min.io/v1beta1"
+	bucketlisters "github.com/minio/operator/pkg/client/listers/bucket.min.io/v1alpha1"
+	iamlisters "github.com/minio/operator/pkg/client/listers/iam.min.io/v1alpha1"
 	"github.com/minio/operator/pkg/resources/statefulsets"
 )
 
@@ -112,13 +118,64 @@ const (
 	StatusUpdatingMinIOVersion       = "Updating MinIO Version"
 	StatusUpdatingKES                = "Updating KES"
 	StatusNotOwned                   = "Statefulset not controlled by operator"
-	StatusFailedAlreadyExists        = "Another MinIO Tenant already exists in the namespace"
 	StatusTenantCredentialsNotSet    = "Tenant credentials are not set properly"
 	StatusInconsistentMinIOVersions  = "Different versions across MinIO Pools"
 	StatusRestartingMinIO            = "Restarting MinIO"
 	StatusDecommissioningNotAllowed  = "Pool Decommissioning Not Allowed"
+	StatusSuspended                  = "Suspended"
 )
 
+// Standard Condition reasons for Tenant
+const (
+	InitializedReason               = "Initialized"
+	ReconcileErrorReason            = "ReconcileError"
+	InvalidSpecReason               = "InvalidSpec"
+	MissingCredentialsReason        = "MissingCredentials"
+	PoolServersModifiedReason       = "PoolServersModified"
+	PoolsInitializingReason         = "PoolsInitializing"
+	UpgradeFailedReason             = "UpgradeFailed"
+	UpgradeCompletedReason          = "UpgradeCompleted"
+	UpgradeRolledBackReason         = "UpgradeRolledBack"
+	KESReadyReason                  = "KESReady"
+	KESNotReadyReason               = "KESNotReady"
+	KESDisabledReason               = "KESDisabled"
+	HealthGreenReason               = "HealthGreen"
+	HealthYellowReason              = "HealthYellow"
+	HealthRedReason                 = "HealthRed"
+	DecommissioningNotAllowedReason = "DecommissioningNotAllowed"
+	VolumeExpansionNotAllowedReason = "VolumeExpansionNotAllowed"
+	VolumeExpandingReason           = "VolumeExpanding"
+	VolumeExpansionFailedReason     = "VolumeExpansionFailed"
+	VolumeExpansionCompletedReason  = "VolumeExpansionCompleted"
+	SuspendedReason                 = "Suspended"
+	ReconcilePausedReason           = "ReconcilePaused"
+	ReconcileResumedReason          = "ReconcileResumed"
+)
+
+// tenantStateReasons maps the Standard Status messages to the reason of the conditions they set
+var tenantStateReasons = map[string]string{
+	StatusInitialized:                InitializedReason,
+	StatusProvisioningCIService:      "ProvisioningCIService",
+	StatusProvisioningHLService:      "ProvisioningHLService",
+	StatusProvisioningStatefulSet:    "ProvisioningStatefulSet",
+	StatusProvisioningConsoleService: "ProvisioningConsoleService",
+	StatusProvisioningKESStatefulSet: "ProvisioningKESStatefulSet",
+	StatusProvisioningInitialUsers:   "ProvisioningInitialUsers",
+	StatusWaitingMinIOIsHealthy:      WaitingMinIOIsHealthyReason,
+	StatusProvisioningDefaultBuckets: "ProvisioningDefaultBuckets",
+	StatusWaitingMinIOCert:           "WaitingMinIOCert",
+	StatusWaitingMinIOClientCert:     "WaitingMinIOClientCert",
+	StatusWaitingKESCert:             "WaitingKESCert",
+	StatusUpdatingMinIOVersion:       "UpdatingMinIOVersion",
+	StatusUpdatingKES:                "UpdatingKES",
+	StatusNotOwned:                   "NotOwned",
+	StatusTenantCredentialsNotSet:    "CredentialsNotSet",
+	StatusInconsistentMinIOVersions:  "InconsistentMinIOVersions",
+	StatusRestartingMinIO:            "RestartingMinIO",
+	StatusDecommissioningNotAllowed:  DecommissioningNotAllowedReason,
+	StatusSuspended:                  SuspendedReason,
+}
+
 // ErrMinIONotReady is the error returned when MinIO is not Ready
 var ErrMinIONotReady = fmt.Errorf("MinIO is not ready")
 
@@ -186,6 +243,9 @@ type Controller struct {
 	// STS API server instance
 	sts *http.Server
 
+	// Admission webhook server instance
+	admission *http.Server
+
 	// Client transport
 	transport *http.Transport
 
@@ -203,6 +263,46 @@ type Controller struct {
 	// has synced at least once.
 	policyBindingListerSynced cache.InformerSynced
 
+	// bucketLister is able to list/get Buckets from a shared informer's store.
+	bucketLister bucketlisters.BucketLister
+	// bucketListerSynced returns true if the Bucket shared informer
+	// has synced at least once.
+	bucketListerSynced cache.InformerSynced
+	// bucketQueue is a rate limited work queue of the Buckets to sync with their Tenant.
+	bucketQueue queue.RateLimitingInterface
+
+	// policyLister is able to list/get MinIOPolicies from a shared informer's store.
+	policyLister iamlisters.MinIOPolicyLister
+	// policyListerSynced returns true if the MinIOPolicy shared informer
+	// has synced at least once.
+	policyListerSynced cache.InformerSynced
+	// policyQueue is a rate limited work queue of the MinIOPolicies to sync with their Tenant.
+	policyQueue queue.RateLimitingInterface
+
+	// userLister is able to list/get MinIOUsers from a shared informer's store.
+	userLister iamlisters.MinIOUserLister
+	// userListerSynced returns true if the MinIOUser shared informer
+	// has synced at least once.
+	userListerSynced cache.InformerSynced
+	// userQueue is a rate limited work queue of the MinIOUsers to sync with their Tenant.
+	userQueue queue.RateLimitingInterface
+
+	// groupLister is able to list/get MinIOGroups from a shared informer's store.
+	groupLister iamlisters.MinIOGroupLister
+	// groupListerSynced returns true if the MinIOGroup shared informer
+	// has synced at least once.
+	groupListerSynced cache.InformerSynced
+	// groupQueue is a rate limited work queue of the MinIOGroups to sync with their Tenant.
+	groupQueue queue.RateLimitingInterface
+
+	// accessKeyLister is able to list/get MinIOAccessKeys from a shared informer's store.
+	accessKeyLister iamlisters.MinIOAccessKeyLister
+	// accessKeyListerSynced returns true if the MinIOAccessKey shared informer
+	// has synced at least once.
+	accessKeyListerSynced cache.InformerSynced
+	// accessKeyQueue is a rate limited work queue of the MinIOAccessKeys to sync with their Tenant.
+	accessKeyQueue queue.RateLimitingInterface
+
 	// controllers denotes the list of components controlled
 	// by the controller. Each component is itself
 	// a controller. This handle is for supporting the abstraction.
@@ -215,6 +315,7 @@ type EventType int
 // Possible values of EventType
 const (
 	STSServerNotification EventType = iota
+	AdmissionServerNotification
 )
 
 // EventNotification - structure to send messages through a channel regarding a error event to be handled
@@ -239,6 +340,11 @@ func NewController(
 	tenantInformer informers.TenantInformer,
 	policyBindingInformer stsInformers.PolicyBindingInformer,
 	minioJobInformer jobinformers.MinIOJobInformer,
+	bucketInformer bucketinformers.BucketInformer,
+	policyInformer iaminformers.MinIOPolicyInformer,
+	userInformer iaminformers.MinIOUserInformer,
+	groupInformer iaminformers.MinIOGroupInformer,
+	accessKeyInformer iaminformers.MinIOAccessKeyInformer,
 	kubeInformerFactoryInOperatorNamespace kubeinformers.SharedInformerFactory,
 ) *Controller {
 	statefulSetInformer := kubeInformerFactory.Apps().V1().StatefulSets()
@@ -289,6 +395,21 @@ func NewController(
 		hostsTemplate:             hostsTemplate,
 		operatorVersion:           operatorVersion,
 		policyBindingListerSynced: policyBindingInformer.Informer().HasSynced,
+		bucketLister:              bucketInformer.Lister(),
+		bucketListerSynced:        bucketInformer.Informer().HasSynced,
+		bucketQueue:               queue.NewRateLimitingQueueWithConfig(MinIOControllerRateLimiter(), queue.RateLimitingQueueConfig{Name: "Buckets"}),
+		policyLister:              policyInformer.Lister(),
+		policyListerSynced:        policyInformer.Informer().HasSynced,
+		policyQueue:               queue.NewRateLimitingQueueWithConfig(MinIOControllerRateLimiter(), queue.RateLimitingQueueConfig{Name: "MinIOPolicies"}),
+		userLister:                userInformer.Lister(),
+		userListerSynced:          userInformer.Informer().HasSynced,
+		userQueue:                 queue.NewRateLimitingQueueWithConfig(MinIOControllerRateLimiter(), queue.RateLimitingQueueConfig{Name: "MinIOUsers"}),
+		groupLister:               groupInformer.Lister(),
+		groupListerSynced:         groupInformer.Informer().HasSynced,
+		groupQueue:                queue.NewRateLimitingQueueWithConfig(MinIOControllerRateLimiter(), queue.RateLimitingQueueConfig{Name: "MinIOGroups"}),
+		accessKeyLister:           accessKeyInformer.Lister(),
+		accessKeyListerSynced:     accessKeyInformer.Informer().HasSynced,
+		accessKeyQueue:            queue.NewRateLimitingQueueWithConfig(MinIOControllerRateLimiter(), queue.RateLimitingQueueConfig{Name: "MinIOAccessKeys"}),
 		controllers: []*JobController{
 			NewJobController(
 				minioJobInformer,
@@ -308,6 +429,9 @@ func NewController(
 	// Initialize STS API server handlers
 	controller.sts = configureSTSServer(controller)
 
+	// Initialize admission webhook server handlers
+	controller.admission = configureAdmissionServer(controller)
+
 	klog.Info("Setting up event handlers")
 	// Set up an event handler for when Tenant resources change
 	tenantInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -324,6 +448,66 @@ func NewController(
 		},
 	})
 
+	bucketInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueBucket,
+		UpdateFunc: func(old, new interface{}) {
+			oldBucket := old.(*bucketv1alpha1.Bucket)
+			newBucket := new.(*bucketv1alpha1.Bucket)
+			if newBucket.ResourceVersion == oldBucket.ResourceVersion {
+				return
+			}
+			controller.enqueueBucket(new)
+		},
+	})
+
+	policyInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueuePolicy,
+		UpdateFunc: func(old, new interface{}) {
+			oldPolicy := old.(*iamv1alpha1.MinIOPolicy)
+			newPolicy := new.(*iamv1alpha1.MinIOPolicy)
+			if newPolicy.ResourceVersion == oldPolicy.ResourceVersion {
+				return
+			}
+			controller.enqueuePolicy(new)
+		},
+	})
+
+	userInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueUser,
+		UpdateFunc: func(old, new interface{}) {
+			oldUser := old.(*iamv1alpha1.MinIOUser)
+			newUser := new.(*iamv1alpha1.MinIOUser)
+			if newUser.ResourceVersion == oldUser.ResourceVersion {
+				return
+			}
+			controller.enqueueUser(new)
+		},
+	})
+
+	groupInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueGroup,
+		UpdateFunc: func(old, new interface{}) {
+			oldGroup := old.(*iamv1alpha1.MinIOGroup)
+			newGroup := new.(*iamv1alpha1.MinIOGroup)
+			if newGroup.ResourceVersion == oldGroup.ResourceVersion {
+				return
+			}
+			controller.enqueueGroup(new)
+		},
+	})
+
+	accessKeyInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueAccessKey,
+		UpdateFunc: func(old, new interface{}) {
+			oldAccessKey := old.(*iamv1alpha1.MinIOAccessKey)
+			newAccessKey := new.(*iamv1alpha1.MinIOAccessKey)
+			if newAccessKey.ResourceVersion == oldAccessKey.ResourceVersion {
+				return
+			}
+			controller.enqueueAccessKey(new)
+		},
+	})
+
 	// Set up an event handler for when StatefulSet resources change. This
 	// handler will lookup the owner of the given StatefulSet, and if it is
 	// owned by a Tenant resource will enqueue that Tenant resource for
@@ -451,7 +635,7 @@ func leaderRun(ctx context.Context, c *Controller, threadiness int, stopCh <-cha
 
 	// Wait for the caches to be synced before starting workers
 	klog.Info("Waiting for informer caches to sync")
-	if ok := cache.WaitForCacheSync(stopCh, c.statefulSetListerSynced, c.deploymentListerSynced, c.tenantsSynced, c.policyBindingListerSynced, c.secretListerSynced); !ok {
+	if ok := cache.WaitForCacheSync(stopCh, c.statefulSetListerSynced, c.deploymentListerSynced, c.tenantsSynced, c.policyBindingListerSynced, c.secretListerSynced, c.bucketListerSynced, c.policyListerSynced, c.userListerSynced, c.groupListerSynced, c.accessKeyListerSynced); !ok {
 		panic("failed to wait for caches to sync")
 	}
 	// Wait for the caches to be synced before starting workers
@@ -467,6 +651,11 @@ func leaderRun(ctx context.Context, c *Controller, threadiness int, stopCh <-cha
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(JobController.runJobWorker, time.Second, stopCh)
 		go wait.Until(c.runWorker, time.Second, stopCh)
+		go wait.Until(c.runBucketWorker, time.Second, stopCh)
+		go wait.Until(c.runPolicyWorker, time.Second, stopCh)
+		go wait.Until(c.runUserWorker, time.Second, stopCh)
+		go wait.Until(c.runGroupWorker, time.Second, stopCh)
+		go wait.Until(c.runAccessKeyWorker, time.Second, stopCh)
 	}
 
 	// Launch a single worker for Health Check reacting to Pod Changes
@@ -488,10 +677,27 @@ func leaderRun(ctx context.Context, c *Controller, threadiness int, stopCh <-cha
 		}()
 	}
 
+	// 3) we need to make sure we have admission webhook certificates and the webhooks registered (if enabled)
+	if IsAdmissionEnabled() {
+		go func() {
+			c.generateAdmissionTLSCert()
+			if err := c.registerAdmissionWebhooks(ctx); err != nil {
+				klog.Errorf("Error registering admission webhooks: %v", err)
+			}
+		}()
+	}
+
 	for {
 		select {
 		case oerr := <-notificationChannel:
-			if !errors.Is(oerr.Err, http.ErrServerClosed) {
+			if errors.Is(oerr.Err, http.ErrServerClosed) {
+				continue
+			}
+			switch oerr.Type {
+			case AdmissionServerNotification:
+				klog.Errorf("Admission webhook Server stopped: %v, going to restart", oerr.Err)
+				go c.startAdmissionServer(ctx, notificationChannel)
+			default:
 				klog.Errorf("STS API Server stopped: %v, going to restart", oerr.Err)
 				go c.startSTSAPIServer(ctx, notificationChannel)
 			}
@@ -564,6 +770,14 @@ func (c *Controller) Start(threadiness int, stopCh <-chan struct{}) error {
 		klog.Info("STS Api server is not enabled, not starting")
 	}
 
+	if IsAdmissionEnabled() {
+		// the admission webhook server runs on every pod, the service only routes to the leader
+		klog.Info("Waiting for Admission webhook server to start")
+		go c.startAdmissionServer(ctx, notificationChannel)
+	} else {
+		klog.Info("Admission webhook server is not enabled, not starting")
+	}
+
 	// start the leader election code loop
 	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 		Lock: lock,
@@ -634,11 +848,17 @@ func (c *Controller) Stop() {
 	tctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	_ = c.us.Shutdown(tctx)
 	_ = c.sts.Shutdown(tctx)
+	_ = c.admission.Shutdown(tctx)
 	cancel()
 
 	klog.Info("Stopping the minio controller")
 	c.workqueue.ShutDown()
 	c.healthCheckQueue.ShutDown()
+	c.bucketQueue.ShutDown()
+	c.policyQueue.ShutDown()
+	c.userQueue.ShutDown()
+	c.groupQueue.ShutDown()
+	c.accessKeyQueue.ShutDown()
 }
 
 // runWorker is a long-running function that will continually call the
@@ -681,7 +901,7 @@ func (c *Controller) updateServer(
 	result, err := adminClnt.ServerUpdateV2(ctx, madmin.ServerUpdateOpts{UpdateURL: updateURL})
 	if err != nil {
 		if madmin.ToErrorResponse(err).Code != "MethodNotAllowed" {
-			if _, terr := c.updateTenantStatus(ctx, tenant, err.Error(), totalAvailableReplicas); terr != nil {
+			if _, terr := c.updateTenantStatusWithConditions(ctx, tenant, err.Error(), totalAvailableReplicas, upgradeFailedTenantConditions(err.Error())...); terr != nil {
 				return terr
 			}
 			// Update failed, nothing needs to be changed in the container
@@ -725,7 +945,7 @@ func (c *Controller) updateServer(
 	}
 
 	if err := reduceErrors(result.Results); err != nil {
-		if _, terr := c.updateTenantStatus(ctx, tenant, err.Error(), totalAvailableReplicas); terr != nil {
+		if _, terr := c.updateTenantStatusWithConditions(ctx, tenant, err.Error(), totalAvailableReplicas, upgradeFailedTenantConditions(err.Error())...); terr != nil {
 			return terr
 		}
 		// Update failed, nothing needs to be changed in the container
@@ -757,7 +977,8 @@ func (c *Controller) updateServer(
 			currentVersion,
 		)
 		klog.Info(msg)
-		if _, terr := c.updateTenantStatus(ctx, tenant, msg, totalAvailableReplicas); terr != nil {
+		upToDate := newTenantCondition(miniov2.TenantConditionUpgradeInProgress, metav1.ConditionFalse, UpgradeCompletedReason, msg)
+		if _, terr := c.updateTenantStatusWithConditions(ctx, tenant, msg, totalAvailableReplicas, upToDate); terr != nil {
 			return terr
 		}
 	}
@@ -804,6 +1025,19 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		return WrapResult(Result{RequeueAfter: time.Second * 5}, nil)
 	}
 
+	// The tenant is being deleted, clean up what doesn't go away with it
+	if tenant.DeletionTimestamp != nil {
+		return WrapResult(Result{}, c.finalizeTenant(ctx, key, tenant))
+	}
+
+	// Leave the tenant as it is while it's paused, only its health is reported
+	if tenant.Spec.Paused {
+		return WrapResult(Result{}, c.pauseReconcile(ctx, key, tenant))
+	}
+	if tenant, err = c.resumeReconcile(ctx, key, tenant); err != nil {
+		return WrapResult(Result{}, err)
+	}
+
 	// Check the Sync Version to see if the tenant needs upgrade
 	if tenant, err = c.checkForUpgrades(ctx, tenant); err != nil {
 		return WrapResult(Result{}, err)
@@ -816,7 +1050,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 	tenantConfiguration, err := c.getTenantCredentials(ctx, tenant)
 	if err != nil {
 		if errors.Is(err, ErrEmptyRootCredentials) {
-			if _, err2 := c.updateTenantStatus(ctx, tenant, err.Error(), 0); err2 != nil {
+			if _, err2 := c.updateTenantStatusWithConditions(ctx, tenant, err.Error(), 0, degradedTenantConditions(MissingCredentialsReason, err.Error())...); err2 != nil {
 				klog.V(2).Infof(err2.Error())
 			}
 			c.recorder.Event(tenant, corev1.EventTypeWarning, "MissingCreds", "Tenant is missing root credentials")
@@ -836,19 +1070,33 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		return WrapResult(Result{}, err)
 	}
 
+	// Move the pools being replaced one step forward, the update of the spec queues a new sync
+	var migrated bool
+	if tenant, migrated, err = c.migratePools(ctx, key, tenant); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	if migrated {
+		return WrapResult(Result{}, nil)
+	}
+
 	tenant.EnsureDefaults()
 
 	// Validate the MinIO Tenant
 	if err = tenant.Validate(); err != nil {
 		klog.V(2).Infof(err.Error())
 		var err2 error
-		if _, err2 = c.updateTenantStatus(ctx, tenant, err.Error(), 0); err2 != nil {
+		if _, err2 = c.updateTenantStatusWithConditions(ctx, tenant, err.Error(), 0, degradedTenantConditions(InvalidSpecReason, err.Error())...); err2 != nil {
 			klog.V(2).Infof(err2.Error())
 		}
 		// return nil so we don't re-queue this work item
 		return WrapResult(Result{}, nil)
 	}
 
+	// Add the finalizer once the tenant is known to be valid, so an invalid spec is reported first
+	if tenant, err = c.ensureTenantFinalizer(ctx, tenant); err != nil {
+		return WrapResult(Result{}, err)
+	}
+
 	// AutoCertEnabled verification is used to manage the tenant migration between v1 and v2
 	// Previous behavior was that AutoCert is disabled by default if RequestAutoCert is nil
 	// New behavior is that AutoCert is enabled by default if RequestAutoCert is nil
@@ -919,29 +1167,27 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		return WrapResult(Result{}, err)
 	}
 
-	// List all MinIO Tenants in this namespace.
-	li, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).List(context.Background(), metav1.ListOptions{})
+	// Create Tenant Services Accoutns for Tenant
+	err = c.checkAndCreateServiceAccount(ctx, tenant)
 	if err != nil {
 		return WrapResult(Result{}, err)
 	}
 
-	// Only 1 minio tenant per namespace allowed.
-	if len(li.Items) > 1 {
-		for _, t := range li.Items {
-			if t.Status.CurrentState != StatusInitialized {
-				if _, err = c.updateTenantStatus(ctx, &t, StatusFailedAlreadyExists, 0); err != nil {
-					return WrapResult(Result{}, err)
-				}
-				// return nil so we don't re-queue this work item
-				return WrapResult(Result{}, err)
-			}
+	// Scale the tenant down while it's suspended, and back up once it's resumed
+	if tenant.Spec.Suspend {
+		if _, err = c.suspendTenant(ctx, key, tenant); err != nil {
+			return WrapResult(Result{}, err)
 		}
+		return WrapResult(Result{}, nil)
 	}
-	// Create Tenant Services Accoutns for Tenant
-	err = c.checkAndCreateServiceAccount(ctx, tenant)
-	if err != nil {
+	var resumed bool
+	if _, resumed, err = c.resumeTenant(ctx, key, tenant); err != nil {
 		return WrapResult(Result{}, err)
 	}
+	if resumed {
+		// continue once the statefulsets scaled up are in the cache
+		return WrapResult(Result{RequeueAfter: time.Second * 5}, nil)
+	}
 
 	adminClnt, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
 	if err != nil {
@@ -990,7 +1236,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 	addingNewPool := false
 	// count the number of initialized pools, if at least 1 is not Initialized, we are still adding a new pool
 	for _, poolStatus := range tenant.Status.Pools {
-		if poolStatus.State != miniov2.PoolInitialized {
+		if poolStatus.State != miniov2.PoolInitialized && !poolStatus.State.Decommissioning() {
 			addingNewPool = true
 			break
 		}
@@ -1001,7 +1247,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 
 	// Check if we need to create any of the pools. It's important not to update the statefulsets
 	// in this loop because we need all the pools "as they are" for the hot-update below
-	for i, pool := range tenant.Spec.Pools {
+	for _, pool := range tenant.Spec.Pools {
 		// Get the StatefulSet with the name specified in Tenant.status.pools[i].SSName
 
 		// if this index is in the status of pools use it, else capture the desired name in the status and store it
@@ -1035,7 +1281,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 				Tenant:          tenant,
 				SkipEnvVars:     skipEnvVars,
 				Pool:            &pool,
-				PoolStatus:      &tenant.Status.Pools[i],
+				PoolStatus:      poolStatusForStatefulSet(tenant, ssName),
 				ServiceName:     tenant.MinIOHLServiceName(),
 				HostsTemplate:   c.hostsTemplate,
 				OperatorVersion: c.operatorVersion,
@@ -1044,14 +1290,20 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 			if err != nil {
 				return WrapResult(Result{}, err)
 			}
-			c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolCreated", fmt.Sprintf("Tenant pool %s created", pool.Name))
-			// Report the pool is properly created
-			tenant.Status.Pools[i].State = miniov2.PoolCreated
-			// mark we are adding a new pool to the next block can act accordingly
-			addingNewPool = true
-			// push updates to status
-			if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
-				return WrapResult(Result{}, err)
+			poolStatus := poolStatusForStatefulSet(tenant, ssName)
+			// the statefulset of an existing pool is recreated to update its volumeClaimTemplate
+			if poolStatus.State != miniov2.PoolNotCreated {
+				c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolRecreated", fmt.Sprintf("Tenant pool %s statefulset recreated", pool.Name))
+			} else {
+				c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolCreated", fmt.Sprintf("Tenant pool %s created", pool.Name))
+				// Report the pool is properly created
+				poolStatus.State = miniov2.PoolCreated
+				// mark we are adding a new pool to the next block can act accordingly
+				addingNewPool = true
+				// push updates to status
+				if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+					return WrapResult(Result{}, err)
+				}
 			}
 		}
 
@@ -1064,7 +1316,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 	// validate each pool if it's initialized, and mark it if it is.
 	for pi, pool := range tenant.Spec.Pools {
 		// get a pod for the established statefulset
-		if tenant.Status.Pools[pi].State == miniov2.PoolInitialized {
+		if poolStatusForIndex(tenant, pi).State == miniov2.PoolInitialized {
 			initializedPool = pool
 			continue
 		}
@@ -1073,6 +1325,13 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		// Only restart if there is an existing initialized pool, if there are no initialized
 		// pools no need to restart.
 		if initializedPool.Name != "" && addingNewPool {
+			var allowed bool
+			if tenant, allowed, err = c.checkMaintenanceWindow(ctx, key, tenant, miniov2.MaintenancePoolRestart, fmt.Sprintf("Restart pool %s to add pool %s", initializedPool.Name, pool.Name)); err != nil {
+				return WrapResult(Result{}, err)
+			}
+			if !allowed {
+				return WrapResult(maintenanceRequeue(tenant), nil)
+			}
 			// Restart services to get new args since we are expanding the deployment here.
 			if err := c.restartInitializedPool(ctx, tenant, initializedPool, tenantConfiguration); err != nil {
 				klog.Infof("'%s' restart call failed", key)
@@ -1081,6 +1340,13 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 			metaNowTime := metav1.Now()
 			tenant.Status.WaitingOnReady = &metaNowTime
 			tenant.Status.CurrentState = StatusRestartingMinIO
+			setTenantConditions(tenant, tenantConditionsForState(StatusRestartingMinIO)...)
+			// rebalance the data once all the pools are initialized
+			if tenant.HasRebalanceEnabled() && !tenant.RebalanceInProgress() {
+				tenant.Status.Rebalance = &miniov2.RebalanceStatus{
+					State: miniov2.RebalancePending,
+				}
+			}
 			if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
 				klog.Infof("'%s' Can't update tenant status: %v", key, err)
 				return WrapResult(Result{}, err)
@@ -1090,7 +1356,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		}
 
 		// Report the pool is initialized.
-		tenant.Status.Pools[pi].State = miniov2.PoolInitialized
+		poolStatusForIndex(tenant, pi).State = miniov2.PoolInitialized
 		// push updates to status
 		if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
 			return WrapResult(Result{}, err)
@@ -1103,7 +1369,11 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 
 	// wait here until all pools are initialized, so we can continue with updating versions and the existingSS resources.
 	for _, poolStatus := range tenant.Status.Pools {
-		if poolStatus.State != miniov2.PoolInitialized {
+		if poolStatus.State != miniov2.PoolInitialized && !poolStatus.State.Decommissioning() {
+			poolsInitializing := newTenantCondition(miniov2.TenantConditionPoolsInitialized, metav1.ConditionFalse, PoolsInitializingReason, fmt.Sprintf("Waiting for pool %s to initialize", poolStatus.SSName))
+			if _, err = c.updateTenantConditions(ctx, tenant, poolsInitializing); err != nil {
+				klog.Infof("'%s' Can't update tenant conditions: %v", key, err)
+			}
 			// at least 1 is not initialized, stop here until they all are.
 			return WrapResult(Result{}, errors.New("Waiting for all pools to initialize"))
 		}
@@ -1130,6 +1400,35 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		}
 	}
 
+	// Start, follow or stop the rebalance of the data across the pools
+	// a rebalance MinIO refuses, for example while a pool is decommissioned, is retried with a backoff without
+	// holding back the rest of the reconcile
+	if rebalanced, err := c.checkForRebalance(ctx, key, tenant, tenantConfiguration); err != nil {
+		klog.Infof("'%s' Can't rebalance the tenant: %v", key, err)
+	} else {
+		tenant = rebalanced
+	}
+
+	// Track the last known good image, and roll back upgrades that don't become healthy in time
+	var rolledBack bool
+	if tenant, rolledBack, err = c.checkUpgradeRollback(ctx, key, tenant, skipEnvVars); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	if rolledBack {
+		return WrapResult(Result{RequeueAfter: time.Second * 10}, nil)
+	}
+
+	// Keep restarting the pods of a rolling upgrade in progress
+	if tenant.Status.RollingUpgrade != nil {
+		var upgrading bool
+		if tenant, upgrading, err = c.rollingUpgrade(ctx, key, tenant, skipEnvVars); err != nil {
+			return WrapResult(Result{}, err)
+		}
+		if upgrading {
+			return WrapResult(Result{RequeueAfter: time.Second * 10}, nil)
+		}
+	}
+
 	// compare all the images across all pools, they should always be the same.
 	compareImage := ""
 	for i, image := range images {
@@ -1146,22 +1445,44 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 
 	// In loop above we compared all the versions in all pools.
 	// So comparing tenant.Spec.Image (version to update to) against one value from images slice is fine.
-	ssImages := strings.Split(images[0], ":")
-	specImages := strings.Split(tenant.Spec.Image, ":")
-	var ssImage string
-	var specImage string
-	if len(specImages) > 1 {
-		specImage = specImages[1]
-	}
-	if len(ssImages) > 1 {
-		ssImage = ssImages[1]
-	}
+	ssImage := imageTag(images[0])
+	specImage := imageTag(tenant.MinIOImage())
+	upgradeAllowed := true
 	if specImage != ssImage && tenant.Status.CurrentState != StatusUpdatingMinIOVersion {
+		msg := fmt.Sprintf("Upgrade MinIO from %s to %s", images[0], tenant.MinIOImage())
+		if tenant, upgradeAllowed, err = c.checkMaintenanceWindow(ctx, key, tenant, miniov2.MaintenanceUpgrade, msg); err != nil {
+			return WrapResult(Result{}, err)
+		}
+	}
+	if specImage != ssImage && tenant.Status.CurrentState != StatusUpdatingMinIOVersion && upgradeAllowed {
 		if !tenant.MinIOHealthCheck(c.getTransport()) {
 			klog.Infof("%s is not running can't update image online", key)
 			return WrapResult(Result{}, ErrMinIONotReady)
 		}
 
+		// Refuse the upgrade if the image doesn't carry the signatures required by the tenant
+		var latest string
+		if tenant, latest, err = c.verifyTenantImage(ctx, key, tenant); err != nil {
+			if tenant != nil {
+				if _, terr := c.updateTenantStatusWithConditions(ctx, tenant, err.Error(), totalAvailableReplicas, upgradeFailedTenantConditions(err.Error())...); terr != nil {
+					return WrapResult(Result{}, terr)
+				}
+			}
+			return WrapResult(Result{}, err)
+		}
+		if latest != "" {
+			defer c.removeArtifacts()
+		}
+
+		// Keep track of when the upgrade started, so it can be rolled back if the tenant doesn't become healthy
+		if tenant.Status.UpgradeStartTime == nil {
+			now := metav1.Now()
+			tenant.Status.UpgradeStartTime = &now
+			if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+				return WrapResult(Result{}, err)
+			}
+		}
+
 		// Images different with the newer state change, continue to verify
 		// if upgrade is possible
 		tenant, err = c.updateTenantStatus(ctx, tenant, StatusUpdatingMinIOVersion, totalAvailableReplicas)
@@ -1169,15 +1490,25 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 			return WrapResult(Result{}, err)
 		}
 
+		// The pods get restarted one at a time with the new image instead of updating their binary
+		if tenant.HasRollingUpgradeStrategy() {
+			if _, err = c.startRollingUpgrade(ctx, key, tenant); err != nil {
+				return WrapResult(Result{}, err)
+			}
+			return WrapResult(Result{RequeueAfter: time.Second * 5}, nil)
+		}
+
 		klog.V(4).Infof("Collecting artifacts for Tenant '%s' to update MinIO from: %s, to: %s",
 			tenantName, images[0], tenant.Spec.Image)
 
-		latest, err := c.fetchArtifacts(tenant)
-		if err != nil {
-			// Do not remove assets with errors, keep them for investigation.
-			return WrapResult(Result{}, err)
+		// the artifacts were already fetched to verify the MinIO binary
+		if latest == "" {
+			if latest, err = c.fetchArtifacts(tenant, tenant.MinIOImage()); err != nil {
+				// Do not remove assets with errors, keep them for investigation.
+				return WrapResult(Result{}, err)
+			}
+			defer c.removeArtifacts()
 		}
-		defer c.removeArtifacts()
 		updateURL, err := tenant.UpdateURL(latest, fmt.Sprintf("http://operator.%s.svc.%s:%s%s",
 			miniov2.GetNSFromFile(),
 			miniov2.GetClusterDomain(),
@@ -1186,7 +1517,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		))
 		if err != nil {
 			err = fmt.Errorf("Unable to get canonical update URL for Tenant '%s', failed with %v", tenantName, err)
-			if _, terr := c.updateTenantStatus(ctx, tenant, err.Error(), totalAvailableReplicas); terr != nil {
+			if _, terr := c.updateTenantStatusWithConditions(ctx, tenant, err.Error(), totalAvailableReplicas, upgradeFailedTenantConditions(err.Error())...); terr != nil {
 				return WrapResult(Result{}, terr)
 			}
 
@@ -1214,7 +1545,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 				Tenant:          tenant,
 				SkipEnvVars:     skipEnvVars,
 				Pool:            &pool,
-				PoolStatus:      &tenant.Status.Pools[i],
+				PoolStatus:      poolStatusForIndex(tenant, i),
 				ServiceName:     tenant.MinIOHLServiceName(),
 				HostsTemplate:   c.hostsTemplate,
 				OperatorVersion: c.operatorVersion,
@@ -1228,7 +1559,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 	}
 
 	// This loop will take care of updating the statefulset for each pool
-	for i, pool := range tenant.Spec.Pools {
+	for _, pool := range tenant.Spec.Pools {
 		// Get the StatefulSet with the name specified in Tenant.status.pools[i].SSName
 		// if this index is in the status of pools use it, else capture the desired name in the status and store it
 		ssName := tenant.PoolStatefulsetName(&pool)
@@ -1260,7 +1591,8 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		}
 		if pool.Servers != *existingStatefulSet.Spec.Replicas {
 			// warn the user that replica count of an existing pool can't be changed
-			if tenant, err = c.updateTenantStatus(ctx, tenant, fmt.Sprintf("Can't modify server count for pool %s", pool.Name), 0); err != nil {
+			msg := fmt.Sprintf("Can't modify server count for pool %s", pool.Name)
+			if tenant, err = c.updateTenantStatusWithConditions(ctx, tenant, msg, 0, degradedTenantConditions(PoolServersModifiedReason, msg)...); err != nil {
 				return WrapResult(Result{}, err)
 			}
 		}
@@ -1269,7 +1601,7 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 			Tenant:          tenant,
 			SkipEnvVars:     skipEnvVars,
 			Pool:            &pool,
-			PoolStatus:      &tenant.Status.Pools[i],
+			PoolStatus:      poolStatusForStatefulSet(tenant, ssName),
 			ServiceName:     tenant.MinIOHLServiceName(),
 			HostsTemplate:   c.hostsTemplate,
 			OperatorVersion: c.operatorVersion,
@@ -1279,8 +1611,15 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		if err != nil {
 			return WrapResult(Result{}, err)
 		}
-		// if the pool doesn't match the spec
+		// the pods of the pool are restarted when its template changes, keep it as it is until a maintenance window opens
+		updateAllowed := true
 		if !poolMatchesSS {
+			if tenant, updateAllowed, err = c.checkMaintenanceWindow(ctx, key, tenant, miniov2.MaintenanceStatefulSetUpdate, fmt.Sprintf("Update the statefulset of pool %s", pool.Name)); err != nil {
+				return WrapResult(Result{}, err)
+			}
+		}
+		// if the pool doesn't match the spec
+		if !poolMatchesSS && updateAllowed {
 			// for legacy reasons, if the zone label is present in SS we must carry it over
 			carryOverLabels := make(map[string]string)
 			if val, ok := existingStatefulSet.Spec.Template.ObjectMeta.Labels[miniov1.ZoneLabel]; ok {
@@ -1291,6 +1630,12 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 
 			newStatefulSet.Spec.Template = expectedStatefulSet.Spec.Template
 			newStatefulSet.Spec.UpdateStrategy = expectedStatefulSet.Spec.UpdateStrategy
+			// keep the labels and annotations in sync, including the hash of the new template
+			newStatefulSet.Labels = expectedStatefulSet.Labels
+			newStatefulSet.Annotations = miniov2.MergeMaps(map[string]string{}, expectedStatefulSet.Annotations)
+			if val, ok := existingStatefulSet.Annotations[corev1.LastAppliedConfigAnnotation]; ok {
+				newStatefulSet.Annotations[corev1.LastAppliedConfigAnnotation] = val
+			}
 
 			if existingStatefulSet.Spec.Template.ObjectMeta.Labels == nil {
 				newStatefulSet.Spec.Template.ObjectMeta.Labels = make(map[string]string)
@@ -1319,10 +1664,13 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 	}
 
 	// Handle PVC expansion
-	err = ExpandPVCs(ctx, c.kubeClientSet, tenant, namespace)
-	if err != nil {
+	var recreatingPools bool
+	if tenant, recreatingPools, err = c.expandPoolVolumes(ctx, key, tenant); err != nil {
 		return WrapResult(Result{}, err)
 	}
+	if recreatingPools {
+		return WrapResult(Result{RequeueAfter: time.Second * 5}, nil)
+	}
 
 	if tenant.HasPrometheusOperatorEnabled() {
 		err := c.checkAndCreatePrometheusAddlConfig(ctx, tenant, string(tenantConfiguration["accesskey"]), string(tenantConfiguration["secretkey"]))
@@ -1367,6 +1715,24 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		}
 	}
 
+	// Apply spec.config to the running MinIO
+	if tenant, err = c.syncTenantConfig(ctx, key, tenant, tenantConfiguration); err != nil {
+		return WrapResult(Result{RequeueAfter: time.Second * 5}, err)
+	}
+
+	// Add a pool if the usage of the tenant crossed the auto expand threshold, the update of the spec queues a new sync
+	var expanded bool
+	if tenant, expanded, err = c.checkAutoExpand(ctx, key, tenant); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	if expanded {
+		return WrapResult(Result{}, nil)
+	}
+
+	if tenant, err = c.clearPendingMaintenance(ctx, tenant); err != nil {
+		return WrapResult(Result{}, err)
+	}
+
 	// Finally, we update the status block of the Tenant resource to reflect the
 	// current state of the world
 	tenant, err = c.updateTenantStatus(ctx, tenant, StatusInitialized, totalAvailableReplicas)
@@ -1377,6 +1743,16 @@ func (c *Controller) syncHandler(key string) (Result, error) {
 		return WrapResult(Result{}, err)
 	}
 
+	// keep polling the progress of the pools being decommissioned or rebalanced
+	if len(tenant.DecommissioningPools()) > 0 || tenant.RebalanceInProgress() {
+		return WrapResult(Result{RequeueAfter: time.Second * 30}, err)
+	}
+
+	// run the disruptive operations left pending once the next maintenance window opens
+	if len(tenant.Status.PendingMaintenance) > 0 {
+		return WrapResult(maintenanceRequeue(tenant), err)
+	}
+
 	return WrapResult(Result{}, err)
 }
 
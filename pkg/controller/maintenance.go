@@ -0,0 +1,104 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// checkMaintenanceWindow returns true if the disruptive operation can run now. Outside the maintenance windows of the
+// tenant the operation is queued in its status, and the caller has to wait for the next window.
+func (c *Controller) checkMaintenanceWindow(ctx context.Context, key string, tenant *miniov2.Tenant, operation miniov2.MaintenanceOperation, msg string) (*miniov2.Tenant, bool, error) {
+	now := time.Now()
+	allowed := tenant.MaintenanceAllowed(now)
+	updated := tenant.DeepCopy()
+	if !queueMaintenance(&updated.Status, operation, msg, !allowed, now, nextMaintenanceWindow(tenant, now)) {
+		return tenant, allowed, nil
+	}
+	if !allowed {
+		klog.Infof("'%s' %s is waiting for a maintenance window", key, operation)
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "MaintenancePending", fmt.Sprintf("%s is waiting for a maintenance window: %s", operation, msg))
+	}
+	tenant, err := c.updatePoolStatus(ctx, updated)
+	if err != nil {
+		return nil, false, err
+	}
+	return tenant, allowed, nil
+}
+
+// clearPendingMaintenance drops the queued operations once a maintenance window is open, by then they either ran or
+// are no longer needed
+func (c *Controller) clearPendingMaintenance(ctx context.Context, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	if len(tenant.Status.PendingMaintenance) == 0 || !tenant.MaintenanceAllowed(time.Now()) {
+		return tenant, nil
+	}
+	updated := tenant.DeepCopy()
+	updated.Status.PendingMaintenance = nil
+	updated.Status.NextMaintenanceWindow = nil
+	return c.updatePoolStatus(ctx, updated)
+}
+
+// queueMaintenance adds the operation to the pending operations of the status, or removes it once it runs. Returns
+// true if the status changed.
+func queueMaintenance(status *miniov2.TenantStatus, operation miniov2.MaintenanceOperation, msg string, pending bool, now time.Time, next *metav1.Time) bool {
+	before := status.DeepCopy()
+	var operations []miniov2.PendingMaintenance
+	var queued bool
+	for _, p := range status.PendingMaintenance {
+		if p.Operation == operation {
+			if !pending {
+				continue
+			}
+			queued = true
+			p.Message = msg
+		}
+		operations = append(operations, p)
+	}
+	if pending && !queued {
+		operations = append(operations, miniov2.PendingMaintenance{Operation: operation, Message: msg, QueuedTime: metav1.NewTime(now)})
+	}
+	if len(operations) == 0 {
+		next = nil
+	}
+	status.PendingMaintenance = operations
+	status.NextMaintenanceWindow = next
+	return !equality.Semantic.DeepEqual(before, status)
+}
+
+func nextMaintenanceWindow(tenant *miniov2.Tenant, now time.Time) *metav1.Time {
+	next, ok := tenant.NextMaintenanceWindow(now)
+	if !ok {
+		return nil
+	}
+	nextTime := metav1.NewTime(next)
+	return &nextTime
+}
+
+// maintenanceRequeue returns the result that syncs the tenant again when the next maintenance window opens
+func maintenanceRequeue(tenant *miniov2.Tenant) Result {
+	next, ok := tenant.NextMaintenanceWindow(time.Now())
+	if !ok {
+		return Result{}
+	}
+	return Result{RequeueAfter: time.Until(next) + time.Second}
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestQueueMaintenance(t *testing.T) {
+	now := time.Now()
+	next := metav1.NewTime(now.Add(time.Hour).Truncate(time.Minute))
+	status := &miniov2.TenantStatus{}
+
+	if !queueMaintenance(status, miniov2.MaintenanceUpgrade, "Upgrade MinIO", true, now, &next) {
+		t.Fatalf("queueMaintenance() didn't queue the upgrade")
+	}
+	if len(status.PendingMaintenance) != 1 || status.NextMaintenanceWindow == nil {
+		t.Fatalf("queueMaintenance() status = %v, want the upgrade pending", status)
+	}
+	queued := status.PendingMaintenance[0].QueuedTime
+	if queueMaintenance(status, miniov2.MaintenanceUpgrade, "Upgrade MinIO", true, now.Add(time.Minute), &next) {
+		t.Errorf("queueMaintenance() changed the status of an upgrade already pending")
+	}
+	if !status.PendingMaintenance[0].QueuedTime.Equal(&queued) {
+		t.Errorf("queueMaintenance() changed the time the upgrade was queued")
+	}
+	if !queueMaintenance(status, miniov2.MaintenanceStatefulSetUpdate, "Update pool-0", true, now, &next) || len(status.PendingMaintenance) != 2 {
+		t.Errorf("queueMaintenance() status = %v, want two operations pending", status)
+	}
+	queueMaintenance(status, miniov2.MaintenanceUpgrade, "", false, now, &next)
+	queueMaintenance(status, miniov2.MaintenanceStatefulSetUpdate, "", false, now, &next)
+	if len(status.PendingMaintenance) != 0 || status.NextMaintenanceWindow != nil {
+		t.Errorf("queueMaintenance() status = %v, want no operation pending", status)
+	}
+}
@@ -17,6 +17,7 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	"github.com/minio/operator/pkg/resources/services"
@@ -51,6 +52,16 @@ func (c *Controller) checkMinIOSvc(ctx context.Context, tenant *miniov2.Tenant,
 		}
 	}
 
+	// the service is named after the tenant, so it can belong to another tenant or to something else entirely
+	if !metav1.IsControlledBy(svc, tenant) {
+		if _, err = c.updateTenantStatus(ctx, tenant, StatusNotOwned, 0); err != nil {
+			return err
+		}
+		msg := fmt.Sprintf(MessageResourceExists, svc.Name)
+		c.recorder.Event(tenant, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return errors.New(msg)
+	}
+
 	// compare any other change from what is specified on the tenant, since some of the state of the service is saved
 	// on the service.spec we will compare individual parts
 	expectedSvc := services.NewClusterIPForMinIO(tenant)
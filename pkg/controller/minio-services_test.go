@@ -15,11 +15,20 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	miniofake "github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	"github.com/minio/operator/pkg/resources/services"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 )
 
 func Test_minioSvcMatchesSpecification(t *testing.T) {
@@ -377,3 +386,45 @@ func Test_minioSvcMatchesSpecification(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkMinIOSvcNotOwned(t *testing.T) {
+	newTenant := func(name, uid string) *miniov2.Tenant {
+		return &miniov2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(uid)}}
+	}
+	// tenant "a-console" finds the console service of tenant "a" under its name
+	owner := newTenant("a", "a-uid")
+	tenant := newTenant("a-console", "a-console-uid")
+	svc := services.NewClusterIPForMinIO(owner)
+	svc.Name = tenant.MinIOCIServiceName()
+	svc.Spec.Ports[0].Port = 9090
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	indexer.Add(svc)
+	recorder := record.NewFakeRecorder(10)
+	c := &Controller{
+		kubeClientSet:  fake.NewSimpleClientset(svc),
+		minioClientSet: miniofake.NewSimpleClientset(tenant.DeepCopy()),
+		serviceLister:  corelisters.NewServiceLister(indexer),
+		recorder:       recorder,
+	}
+	if err := c.checkMinIOSvc(context.Background(), tenant, types.NamespacedName{Namespace: "ns", Name: tenant.Name}); err == nil {
+		t.Fatal("checkMinIOSvc() must fail on a service it doesn't own")
+	}
+	stored, err := c.kubeClientSet.CoreV1().Services("ns").Get(context.Background(), svc.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Spec.Ports[0].Port != 9090 || !metav1.IsControlledBy(stored, owner) {
+		t.Errorf("checkMinIOSvc() modified a service it doesn't own: %+v", stored)
+	}
+	updated, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status.CurrentState != StatusNotOwned {
+		t.Errorf("tenant state = %s, want %s", updated.Status.CurrentState, StatusNotOwned)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("checkMinIOSvc() emitted %d events, want 1", len(recorder.Events))
+	}
+}
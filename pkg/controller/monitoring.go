@@ -37,6 +37,8 @@ const (
 	HealthHealingMessage = "Healing"
 	// HealthReduceAvailabilityMessage some drives are offline
 	HealthReduceAvailabilityMessage = "Reduced Availability"
+	// HealthSuspendedMessage the tenant was scaled down by spec.suspend
+	HealthSuspendedMessage = "Suspended"
 )
 
 // recurrentTenantStatusMonitor loop that checks every N minutes for tenants health
@@ -73,7 +75,7 @@ func (c *Controller) tenantsHealthMonitor() error {
 			return err
 		}
 		// Add tenant to the health check queue until is green again
-		if tenant != nil && tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		if tenant != nil && tenant.Status.HealthStatus != miniov2.HealthStatusGreen && tenant.Status.HealthStatus != miniov2.HealthStatusSuspended {
 			key := fmt.Sprintf("%s/%s", tenant.GetNamespace(), tenant.GetName())
 			c.healthCheckQueue.Add(key)
 		}
@@ -82,6 +84,17 @@ func (c *Controller) tenantsHealthMonitor() error {
 }
 
 func (c *Controller) updateHealthStatusForTenant(tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	// a suspended tenant has no pods to ask for its health
+	if tenant.Spec.Suspend {
+		if tenant.Status.HealthStatus == miniov2.HealthStatusSuspended {
+			return tenant, nil
+		}
+		tenant.Status.HealthStatus = miniov2.HealthStatusSuspended
+		tenant.Status.HealthMessage = HealthSuspendedMessage
+		setTenantHealthConditions(tenant, false)
+		return c.updatePoolStatus(context.Background(), tenant)
+	}
+
 	// don't get the tenant cluster health if it doesn't have at least 1 pool initialized
 	oneInitialized := false
 	for _, pool := range tenant.Status.Pools {
@@ -105,6 +118,15 @@ func (c *Controller) updateHealthStatusForTenant(tenant *miniov2.Tenant) (*minio
 		return nil, err
 	}
 
+	// pods stuck on lost nodes keep the tenant from being healthy, check them before asking MinIO
+	if !tenant.Spec.Paused {
+		if recovered, err := c.recoverPodsOnLostNodes(context.Background(), tenant, adminClnt); err != nil {
+			klog.Infof("'%s/%s' Failed to check pods on lost nodes: %v", tenant.Namespace, tenant.Name, err)
+		} else {
+			tenant = recovered
+		}
+	}
+
 	aClnt, err := madmin.NewAnonymousClient(tenant.MinIOServerHostAddress(), tenant.TLS())
 	if err != nil {
 		// show the error and continue
@@ -152,6 +174,8 @@ func (c *Controller) updateHealthStatusForTenant(tenant *miniov2.Tenant) (*minio
 	if !allPodsRunning && tenant.Status.HealthStatus != miniov2.HealthStatusRed {
 		tenant.Status.HealthStatus = miniov2.HealthStatusYellow
 	}
+	setTenantHealthConditions(tenant, false)
+	c.setPoolsReadyReplicas(tenant)
 
 	// partial status update, since the storage info might take a while
 	if tenant, err = c.updatePoolStatus(context.Background(), tenant); err != nil {
@@ -233,6 +257,10 @@ bailout:
 
 	tenant.Status.DrivesOnline = onlineDisks
 	tenant.Status.DrivesOffline = offlineDisks
+	setPoolsDrivesStatus(tenant, storageInfo)
+	if !tenant.Spec.Paused {
+		c.replaceFailedDrives(context.Background(), tenant, adminClnt, storageInfo)
+	}
 
 	if tenant.Status.DrivesOffline > 0 || tenant.Status.DrivesHealing > 0 {
 		tenant.Status.HealthStatus = miniov2.HealthStatusYellow
@@ -252,6 +280,7 @@ bailout:
 		tenant.Status.HealthStatus = miniov2.HealthStatusGreen
 		tenant.Status.HealthMessage = ""
 	}
+	setTenantHealthConditions(tenant, false)
 
 	if tenant, err = c.updatePoolStatus(context.Background(), tenant); err != nil {
 		klog.Infof("'%s/%s' Can't update tenant status: %v", tenant.Namespace, tenant.Name, err)
@@ -282,6 +311,92 @@ bailout:
 	return tenant, nil
 }
 
+// poolStatusForIndex returns the status entry of the pool at the given index of the spec
+func poolStatusForIndex(tenant *miniov2.Tenant, pi int) *miniov2.PoolStatus {
+	pool := tenant.Spec.Pools[pi]
+	if pool.Name == "" {
+		pool.Name = fmt.Sprintf("%s-%d", miniov2.StatefulSetPrefix, pi)
+	}
+	return poolStatusForStatefulSet(tenant, tenant.PoolStatefulsetName(&pool))
+}
+
+// poolStatusForStatefulSet returns the status entry of the pool of the StatefulSet
+func poolStatusForStatefulSet(tenant *miniov2.Tenant, ssName string) *miniov2.PoolStatus {
+	for i := range tenant.Status.Pools {
+		if tenant.Status.Pools[i].SSName == ssName {
+			return &tenant.Status.Pools[i]
+		}
+	}
+	return nil
+}
+
+// setPoolsReadyReplicas reports the ready replicas of each pool StatefulSet on its status entry
+func (c *Controller) setPoolsReadyReplicas(tenant *miniov2.Tenant) {
+	for _, ssName := range tenant.MinIOPoolStatefulSets() {
+		poolStatus := poolStatusForStatefulSet(tenant, ssName)
+		if poolStatus == nil {
+			continue
+		}
+		ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(poolStatus.SSName)
+		if err != nil {
+			continue
+		}
+		poolStatus.ReadyReplicas = ss.Status.ReadyReplicas
+	}
+}
+
+// setPoolsDrivesStatus reports the drives, parity, capacity and usage of each pool on its status entry. MinIO indexes
+// the pools by their position in its arguments, which include the pools being decommissioned.
+func setPoolsDrivesStatus(tenant *miniov2.Tenant, storageInfo madmin.StorageInfo) {
+	standardSCData := storageInfo.Backend.StandardSCData
+	standardSCParities := storageInfo.Backend.StandardSCParities
+	for pi, ssName := range tenant.MinIOPoolStatefulSets() {
+		poolStatus := poolStatusForStatefulSet(tenant, ssName)
+		if poolStatus == nil {
+			continue
+		}
+
+		var drivesOnline, drivesOffline, drivesHealing int32
+		var rawCapacity, rawUsage uint64
+		for _, disk := range storageInfo.Disks {
+			if disk.PoolIndex != pi {
+				continue
+			}
+			if disk.State == madmin.DriveStateOk {
+				drivesOnline++
+			} else {
+				drivesOffline++
+			}
+			if disk.Healing {
+				drivesHealing++
+			}
+			rawCapacity = rawCapacity + disk.AvailableSpace
+			rawUsage = rawUsage + disk.UsedSpace
+		}
+		poolStatus.DrivesOnline = drivesOnline
+		poolStatus.DrivesOffline = drivesOffline
+		poolStatus.DrivesHealing = drivesHealing
+		poolStatus.Usage.RawCapacity = safeToInt64(rawCapacity)
+		poolStatus.Usage.RawUsage = safeToInt64(rawUsage)
+
+		if pi >= len(standardSCData) {
+			continue
+		}
+		// Per-pool parity is not always returned, in that case the pool uses the standard parity
+		parity := storageInfo.Backend.StandardSCParity
+		if pi < len(standardSCParities) {
+			parity = standardSCParities[pi]
+		}
+		if standardSCData[pi]+parity == 0 {
+			continue
+		}
+		poolEfficiency := float64(standardSCData[pi]) / float64(standardSCData[pi]+parity)
+		poolStatus.Parity = int32(parity)
+		poolStatus.Usage.Capacity = safeToInt64(uint64(poolEfficiency * float64(rawCapacity)))
+		poolStatus.Usage.Usage = safeToInt64(uint64(poolEfficiency * float64(rawUsage)))
+	}
+}
+
 // HealthResult holds the results from cluster/health query into MinIO
 type HealthResult struct {
 	StatusCode        int
@@ -319,7 +434,7 @@ func (c *Controller) syncHealthCheckHandler(key string) (Result, error) {
 	}
 
 	// Add tenant to the health check queue again until is green again
-	if tenant != nil && tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+	if tenant != nil && tenant.Status.HealthStatus != miniov2.HealthStatusGreen && tenant.Status.HealthStatus != miniov2.HealthStatusSuspended {
 		c.healthCheckQueue.AddAfter(key, 1*time.Second)
 	}
 
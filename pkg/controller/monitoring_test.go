@@ -0,0 +1,108 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_setPoolsDrivesStatus(t *testing.T) {
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant"},
+		Spec: miniov2.TenantSpec{
+			Pools: []miniov2.Pool{{Name: "pool-0"}, {}},
+		},
+		Status: miniov2.TenantStatus{
+			Pools: []miniov2.PoolStatus{
+				{SSName: "tenant-pool-0", State: miniov2.PoolInitialized},
+				{SSName: "tenant-ss-1", State: miniov2.PoolInitialized},
+			},
+		},
+	}
+	storageInfo := madmin.StorageInfo{
+		Disks: []madmin.Disk{
+			{PoolIndex: 0, State: madmin.DriveStateOk, AvailableSpace: 100, UsedSpace: 40},
+			{PoolIndex: 0, State: madmin.DriveStateOk, AvailableSpace: 100, UsedSpace: 40},
+			{PoolIndex: 0, State: madmin.DriveStateOk, AvailableSpace: 100, UsedSpace: 40},
+			{PoolIndex: 0, State: madmin.DriveStateOffline},
+			{PoolIndex: 1, State: madmin.DriveStateOk, AvailableSpace: 200, UsedSpace: 20, Healing: true},
+			{PoolIndex: 1, State: madmin.DriveStateOk, AvailableSpace: 200, UsedSpace: 20},
+		},
+	}
+	storageInfo.Backend.StandardSCData = []int{2, 1}
+	storageInfo.Backend.StandardSCParities = []int{2}
+	storageInfo.Backend.StandardSCParity = 1
+
+	setPoolsDrivesStatus(tenant, storageInfo)
+
+	pool0 := tenant.Status.Pools[0]
+	if pool0.DrivesOnline != 3 || pool0.DrivesOffline != 1 || pool0.DrivesHealing != 0 {
+		t.Errorf("pool-0 drives = %d/%d/%d, want 3/1/0", pool0.DrivesOnline, pool0.DrivesOffline, pool0.DrivesHealing)
+	}
+	if pool0.Parity != 2 || pool0.Usage.RawCapacity != 300 || pool0.Usage.Capacity != 150 || pool0.Usage.Usage != 60 {
+		t.Errorf("pool-0 parity/usage = %d/%+v, want 2/{Capacity:150 RawCapacity:300 Usage:60 RawUsage:120}", pool0.Parity, pool0.Usage)
+	}
+
+	pool1 := tenant.Status.Pools[1]
+	if pool1.DrivesOnline != 2 || pool1.DrivesOffline != 0 || pool1.DrivesHealing != 1 {
+		t.Errorf("ss-1 drives = %d/%d/%d, want 2/0/1", pool1.DrivesOnline, pool1.DrivesOffline, pool1.DrivesHealing)
+	}
+	// ss-1 doesn't report its own parity, the standard parity is used
+	if pool1.Parity != 1 || pool1.Usage.RawCapacity != 400 || pool1.Usage.Capacity != 200 {
+		t.Errorf("ss-1 parity/usage = %d/%+v, want 1/{Capacity:200 RawCapacity:400}", pool1.Parity, pool1.Usage)
+	}
+}
+
+func Test_setPoolsDrivesStatusDecommissioning(t *testing.T) {
+	// pool-0 was removed from the spec and is drained by MinIO, it keeps the first position in the MinIO arguments
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant"},
+		Spec: miniov2.TenantSpec{
+			Pools: []miniov2.Pool{{Name: "pool-1"}},
+		},
+		Status: miniov2.TenantStatus{
+			Pools: []miniov2.PoolStatus{
+				{SSName: "tenant-pool-1", State: miniov2.PoolInitialized},
+				{
+					SSName:       "tenant-pool-0",
+					State:        miniov2.PoolDecommissioning,
+					Decommission: &miniov2.PoolDecommissionStatus{Index: 0, CmdLine: "http://tenant-pool-0-{0...3}.tenant-hl.ns.svc.cluster.local/export{0...3}"},
+				},
+			},
+		},
+	}
+	storageInfo := madmin.StorageInfo{
+		Disks: []madmin.Disk{
+			{PoolIndex: 0, State: madmin.DriveStateOk, AvailableSpace: 100},
+			{PoolIndex: 1, State: madmin.DriveStateOk, AvailableSpace: 200},
+			{PoolIndex: 1, State: madmin.DriveStateOffline},
+		},
+	}
+
+	setPoolsDrivesStatus(tenant, storageInfo)
+
+	if pool1 := tenant.Status.Pools[0]; pool1.DrivesOnline != 1 || pool1.DrivesOffline != 1 || pool1.Usage.RawCapacity != 200 {
+		t.Errorf("pool-1 drives = %d/%d, raw capacity %d, want 1/1, 200", pool1.DrivesOnline, pool1.DrivesOffline, pool1.Usage.RawCapacity)
+	}
+	if pool0 := tenant.Status.Pools[1]; pool0.DrivesOnline != 1 || pool0.DrivesOffline != 0 || pool0.Usage.RawCapacity != 100 {
+		t.Errorf("pool-0 drives = %d/%d, raw capacity %d, want 1/0, 100", pool0.DrivesOnline, pool0.DrivesOffline, pool0.Usage.RawCapacity)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// pauseReconcile reports the tenant as paused, the rest of the reconcile loop is skipped until spec.paused is unset
+func (c *Controller) pauseReconcile(ctx context.Context, key string, tenant *miniov2.Tenant) error {
+	if meta.IsStatusConditionTrue(tenant.Status.Conditions, miniov2.TenantConditionPaused) {
+		return nil
+	}
+	klog.Infof("'%s' Reconciliation paused", key)
+	c.recorder.Event(tenant, corev1.EventTypeNormal, ReconcilePausedReason, "The operator stopped reconciling the tenant, set spec.paused to false to resume")
+	_, err := c.updateTenantConditions(ctx, tenant,
+		newTenantCondition(miniov2.TenantConditionPaused, metav1.ConditionTrue, ReconcilePausedReason, "Reconciliation is paused by spec.paused"))
+	return err
+}
+
+// resumeReconcile clears the paused condition of a tenant that is no longer paused
+func (c *Controller) resumeReconcile(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	if !meta.IsStatusConditionTrue(tenant.Status.Conditions, miniov2.TenantConditionPaused) {
+		return tenant, nil
+	}
+	klog.Infof("'%s' Reconciliation resumed", key)
+	c.recorder.Event(tenant, corev1.EventTypeNormal, ReconcileResumedReason, "The operator resumed reconciling the tenant")
+	return c.updateTenantConditions(ctx, tenant,
+		newTenantCondition(miniov2.TenantConditionPaused, metav1.ConditionFalse, ReconcileResumedReason, "Reconciliation is resumed"))
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	miniofake "github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// pausedTenant returns an initialized tenant with spec.paused set
+func pausedTenant() *miniov2.Tenant {
+	autoCert := false
+	return &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns"},
+		Spec: miniov2.TenantSpec{
+			Paused:           true,
+			RequestAutoCert:  &autoCert,
+			Configuration:    &corev1.LocalObjectReference{Name: "tenant-env-configuration"},
+			Pools:            []miniov2.Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			DriveReplacement: &miniov2.DriveReplacement{Enabled: true},
+			LostNodeRecovery: &miniov2.LostNodeRecovery{Enabled: true},
+		},
+		Status: miniov2.TenantStatus{
+			CurrentState: StatusInitialized,
+			Pools:        []miniov2.PoolStatus{{SSName: "tenant-pool-0", State: miniov2.PoolInitialized}},
+		},
+	}
+}
+
+// specKeepingClientSet returns a clientset holding the tenant that, like the API server, keeps its spec on status
+// updates
+func specKeepingClientSet(tenant *miniov2.Tenant) *miniofake.Clientset {
+	clientSet := miniofake.NewSimpleClientset(tenant.DeepCopy())
+	clientSet.PrependReactor("update", "tenants", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		update := action.(k8stesting.UpdateAction)
+		if update.GetSubresource() != "status" {
+			return false, nil, nil
+		}
+		updated := update.GetObject().(*miniov2.Tenant).DeepCopy()
+		updated.Spec = tenant.Spec
+		return true, updated, clientSet.Tracker().Update(miniov2.SchemeGroupVersion.WithResource("tenants"), updated, update.GetNamespace())
+	})
+	return clientSet
+}
+
+// drainEvents returns the reasons of the events recorded so far
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var reasons []string
+	for len(recorder.Events) > 0 {
+		reasons = append(reasons, strings.Fields(<-recorder.Events)[1])
+	}
+	return reasons
+}
+
+func TestSyncHandlerPausedTenant(t *testing.T) {
+	tenant := pausedTenant()
+	kubeClientSet := fake.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(100)
+	c := &Controller{
+		kubeClientSet:  kubeClientSet,
+		minioClientSet: specKeepingClientSet(tenant),
+		recorder:       recorder,
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.syncHandler("ns/tenant"); err != nil {
+			t.Fatalf("syncHandler() error = %v", err)
+		}
+	}
+
+	// no statefulset, PDB or any other resource is read or written while paused
+	if actions := kubeClientSet.Actions(); len(actions) > 0 {
+		t.Errorf("paused tenant reached the cluster: %v", actions)
+	}
+	stored, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), "tenant", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status.SyncVersion != "" {
+		t.Errorf("paused tenant was upgraded to sync version %s", stored.Status.SyncVersion)
+	}
+	if stored.Status.CurrentState != StatusInitialized {
+		t.Errorf("state = %s, want %s", stored.Status.CurrentState, StatusInitialized)
+	}
+	if !meta.IsStatusConditionTrue(stored.Status.Conditions, miniov2.TenantConditionPaused) {
+		t.Errorf("conditions = %v, want %s", stored.Status.Conditions, miniov2.TenantConditionPaused)
+	}
+	if events := drainEvents(recorder); strings.Join(events, " ") != ReconcilePausedReason {
+		t.Errorf("events = %v, want a single %s", events, ReconcilePausedReason)
+	}
+}
+
+func TestUpdateHealthStatusForPausedTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/minio/health/cluster"):
+			w.Header().Set("x-minio-write-quorum", "9")
+		case strings.HasSuffix(r.URL.Path, "/storageinfo"):
+			var storageInfo madmin.StorageInfo
+			for i := 0; i < 16; i++ {
+				state := madmin.DriveStateOk
+				if i == 0 {
+					state = madmin.DriveStateFaulty
+				}
+				storageInfo.Disks = append(storageInfo.Disks, madmin.Disk{
+					State:    state,
+					Endpoint: fmt.Sprintf("http://tenant-pool-0-%d.tenant-hl.ns.svc.cluster.local:9000/export%d", i/4, i%4),
+				})
+			}
+			json.NewEncoder(w).Encode(storageInfo)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tenant := pausedTenant()
+	kubeClientSet := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-env-configuration", Namespace: "ns"},
+		Data:       map[string][]byte{"config.env": []byte("export MINIO_ROOT_USER=minio\nexport MINIO_ROOT_PASSWORD=minio123")},
+	})
+	c := &Controller{
+		kubeClientSet:     kubeClientSet,
+		minioClientSet:    specKeepingClientSet(tenant),
+		statefulSetLister: appslisters.NewStatefulSetLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		recorder:          record.NewFakeRecorder(100),
+		transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+			},
+		},
+	}
+
+	if _, err := c.updateHealthStatusForTenant(tenant.DeepCopy()); err != nil {
+		t.Fatalf("updateHealthStatusForTenant() error = %v", err)
+	}
+	stored, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), "tenant", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status.HealthStatus != miniov2.HealthStatusYellow || stored.Status.DrivesOnline != 15 || stored.Status.DrivesOffline != 1 {
+		t.Errorf("health = %s with %d drives online and %d offline, want yellow with 15 and 1",
+			stored.Status.HealthStatus, stored.Status.DrivesOnline, stored.Status.DrivesOffline)
+	}
+	// the offline drive and lost nodes are left alone while paused
+	for _, action := range kubeClientSet.Actions() {
+		if action.GetVerb() != "get" && action.GetVerb() != "list" {
+			t.Errorf("paused tenant was changed: %s %s", action.GetVerb(), action.GetResource().Resource)
+		}
+	}
+	if len(stored.Status.DriveReplacements) > 0 {
+		t.Errorf("drive replacements = %v, want none while paused", stored.Status.DriveReplacements)
+	}
+}
+
+func TestResumeReconcile(t *testing.T) {
+	tenant := pausedTenant()
+	tenant.Spec.Paused = false
+	setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionPaused, metav1.ConditionTrue, ReconcilePausedReason, "Reconciliation is paused by spec.paused"))
+	recorder := record.NewFakeRecorder(100)
+	c := &Controller{
+		minioClientSet: miniofake.NewSimpleClientset(tenant.DeepCopy()),
+		recorder:       recorder,
+	}
+
+	resumed := tenant
+	for i := 0; i < 2; i++ {
+		var err error
+		if resumed, err = c.resumeReconcile(context.Background(), "ns/tenant", resumed); err != nil {
+			t.Fatalf("resumeReconcile() error = %v", err)
+		}
+	}
+
+	stored, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), "tenant", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	condition := meta.FindStatusCondition(stored.Status.Conditions, miniov2.TenantConditionPaused)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != ReconcileResumedReason {
+		t.Errorf("paused condition = %+v, want false with reason %s", condition, ReconcileResumedReason)
+	}
+	if events := drainEvents(recorder); strings.Join(events, " ") != ReconcileResumedReason {
+		t.Errorf("events = %v, want a single %s", events, ReconcileResumedReason)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/set"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// iamResyncPeriod is how often an IAM resource is compared with MinIO, to restore the changes made outside of it
+const iamResyncPeriod = 5 * time.Minute
+
+// policyAPI is the part of the MinIO admin client used to sync a policy
+type policyAPI interface {
+	InfoCannedPolicyV2(ctx context.Context, policyName string) (*madmin.PolicyInfo, error)
+	AddCannedPolicy(ctx context.Context, policyName string, policy []byte) error
+	RemoveCannedPolicy(ctx context.Context, policyName string) error
+}
+
+// newTenantAdminClient returns the MinIO admin client used to sync the IAM resources of the tenant
+func (c *Controller) newTenantAdminClient(ctx context.Context, tenant *miniov2.Tenant) (*madmin.AdminClient, error) {
+	tenantConfiguration, err := c.getTenantCredentials(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+}
+
+// enqueuePolicy takes a MinIOPolicy resource and puts its namespace/name key onto the policy queue
+func (c *Controller) enqueuePolicy(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	if !c.namespacesToWatch.IsEmpty() {
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			runtime.HandleError(err)
+			return
+		}
+		if !c.namespacesToWatch.Contains(object.GetNamespace()) {
+			klog.Infof("Ignoring policy `%s` in namespace that is not watched by this controller.", key)
+			return
+		}
+	}
+	c.policyQueue.Add(key)
+}
+
+// runPolicyWorker processes the MinIOPolicies of the policy queue
+func (c *Controller) runPolicyWorker() {
+	defer runtime.HandleCrash()
+	for processNextItem(c.policyQueue, c.syncPolicyHandler) {
+	}
+}
+
+// syncPolicyHandler creates or updates the policy of a MinIOPolicy resource in its tenant
+func (c *Controller) syncPolicyHandler(key string) (Result, error) {
+	ctx := context.Background()
+	namespace, name := key2NamespaceName(key)
+	policy, err := c.policyLister.MinIOPolicies(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, nil)
+		}
+		return WrapResult(Result{}, err)
+	}
+	policy = policy.DeepCopy()
+
+	tenant, err := c.minioClientSet.MinioV2().Tenants(namespace).Get(ctx, policy.Spec.Tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, err)
+		}
+		tenant = nil
+	}
+
+	if policy.DeletionTimestamp != nil {
+		return WrapResult(Result{}, c.finalizePolicy(ctx, key, policy, tenant))
+	}
+	if !controllerutil.ContainsFinalizer(policy, iamv1alpha1.IAMFinalizer) {
+		controllerutil.AddFinalizer(policy, iamv1alpha1.IAMFinalizer)
+		// the update queues the policy again
+		_, err = c.minioClientSet.IamV1alpha1().MinIOPolicies(namespace).Update(ctx, policy, metav1.UpdateOptions{})
+		return WrapResult(Result{}, err)
+	}
+
+	if err = policy.Validate(); err != nil {
+		return WrapResult(Result{}, c.updatePolicyReady(ctx, policy, metav1.ConditionFalse, "InvalidSpec", err.Error()))
+	}
+	if tenant == nil {
+		msg := fmt.Sprintf("Tenant %s not found", policy.Spec.Tenant.Name)
+		return WrapResult(Result{RequeueAfter: 30 * time.Second}, c.updatePolicyReady(ctx, policy, metav1.ConditionFalse, "TenantNotFound", msg))
+	}
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		msg := fmt.Sprintf("Waiting for tenant %s to be healthy", tenant.Name)
+		return WrapResult(Result{RequeueAfter: 10 * time.Second}, c.updatePolicyReady(ctx, policy, metav1.ConditionFalse, "TenantNotReady", msg))
+	}
+
+	adminClient, err := c.newTenantAdminClient(ctx, tenant)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+	changed, err := syncPolicy(ctx, adminClient, policy)
+	if err != nil {
+		klog.Errorf("'%s' Failed to sync policy %s: %v", key, policy.PolicyName(), err)
+		c.recorder.Event(policy, corev1.EventTypeWarning, "PolicySyncFailed", err.Error())
+		if uerr := c.updatePolicyReady(ctx, policy, metav1.ConditionFalse, "SyncFailed", err.Error()); uerr != nil {
+			return WrapResult(Result{}, uerr)
+		}
+		return WrapResult(Result{}, err)
+	}
+
+	// the generation was already synced, so the policy changed in MinIO
+	if changed && policy.Status.ObservedGeneration == policy.Generation {
+		msg := fmt.Sprintf("Restored policy %s, it was changed outside of the MinIOPolicy resource", policy.PolicyName())
+		klog.Infof("'%s' %s", key, msg)
+		c.recorder.Event(policy, corev1.EventTypeWarning, "PolicyDrift", msg)
+		now := metav1.Now()
+		policy.Status.LastDriftTime = &now
+	} else if changed {
+		c.recorder.Event(policy, corev1.EventTypeNormal, "PolicySynced", fmt.Sprintf("Policy %s synced", policy.PolicyName()))
+	}
+	policy.Status.Name = policy.PolicyName()
+	policy.Status.ObservedGeneration = policy.Generation
+	if err = c.updatePolicyReady(ctx, policy, metav1.ConditionTrue, "PolicySynced", "The policy matches its spec"); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	// the policy can be changed outside of the operator, check it again from time to time
+	return WrapResult(Result{RequeueAfter: iamResyncPeriod}, nil)
+}
+
+// finalizePolicy removes the policy of a deleted MinIOPolicy from its tenant
+func (c *Controller) finalizePolicy(ctx context.Context, key string, policy *iamv1alpha1.MinIOPolicy, tenant *miniov2.Tenant) error {
+	if !controllerutil.ContainsFinalizer(policy, iamv1alpha1.IAMFinalizer) {
+		return nil
+	}
+	// the policy is gone along with a deleted tenant
+	if policy.Status.Name != "" && tenant != nil && tenant.DeletionTimestamp == nil {
+		adminClient, err := c.newTenantAdminClient(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if err = removePolicy(ctx, adminClient, policy.Status.Name); err != nil {
+			return err
+		}
+		klog.Infof("'%s' Removed policy %s", key, policy.Status.Name)
+	}
+	controllerutil.RemoveFinalizer(policy, iamv1alpha1.IAMFinalizer)
+	if _, err := c.minioClientSet.IamV1alpha1().MinIOPolicies(policy.Namespace).Update(ctx, policy, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// updatePolicyReady sets the Ready condition of the policy and persists its status if it changed
+func (c *Controller) updatePolicyReady(ctx context.Context, policy *iamv1alpha1.MinIOPolicy, status metav1.ConditionStatus, reason, msg string) error {
+	current, err := c.policyLister.MinIOPolicies(policy.Namespace).Get(policy.Name)
+	if err != nil {
+		return err
+	}
+	updated := policy.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+		Type:               iamv1alpha1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: policy.Generation,
+	})
+	if equality.Semantic.DeepEqual(current.Status, updated.Status) {
+		return nil
+	}
+	_, err = c.minioClientSet.IamV1alpha1().MinIOPolicies(policy.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// syncPolicy creates the policy in MinIO, or replaces it if it doesn't match the spec. Returns true if MinIO was
+// changed.
+func syncPolicy(ctx context.Context, api policyAPI, policy *iamv1alpha1.MinIOPolicy) (bool, error) {
+	name := policy.PolicyName()
+	want, err := policy.ParsePolicy()
+	if err != nil {
+		return false, err
+	}
+	info, err := api.InfoCannedPolicyV2(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchPolicy" {
+		return false, err
+	}
+	if err == nil && info != nil {
+		current, err := iampolicy.ParseConfig(bytes.NewReader(info.Policy))
+		// a policy that can't be parsed is replaced
+		if err == nil && reflect.DeepEqual(current, want) {
+			return false, nil
+		}
+	}
+	if err = api.AddCannedPolicy(ctx, name, policy.Spec.Policy.Raw); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// removePolicy removes the policy from MinIO, a policy that is already gone isn't an error
+func removePolicy(ctx context.Context, api policyAPI, name string) error {
+	err := api.RemoveCannedPolicy(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchPolicy" {
+		return err
+	}
+	return nil
+}
+
+// policyAttachmentAPI is the part of the MinIO admin client used to attach policies to users and groups
+type policyAttachmentAPI interface {
+	AttachPolicy(ctx context.Context, r madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
+	DetachPolicy(ctx context.Context, r madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error)
+}
+
+// syncAttachedPolicies attaches the wanted policies to the user or group and detaches the others. attached is the
+// comma separated list of policies MinIO returns. Returns true if the policies were changed.
+func syncAttachedPolicies(ctx context.Context, api policyAttachmentAPI, attached string, want []string, user, group string) (bool, error) {
+	current := set.NewStringSet()
+	for _, policy := range strings.Split(attached, ",") {
+		if policy = strings.TrimSpace(policy); policy != "" {
+			current.Add(policy)
+		}
+	}
+	wanted := set.CreateStringSet(want...)
+	toAttach := wanted.Difference(current).ToSlice()
+	toDetach := current.Difference(wanted).ToSlice()
+	if len(toAttach) > 0 {
+		if _, err := api.AttachPolicy(ctx, madmin.PolicyAssociationReq{Policies: toAttach, User: user, Group: group}); err != nil {
+			return false, err
+		}
+	}
+	if len(toDetach) > 0 {
+		if _, err := api.DetachPolicy(ctx, madmin.PolicyAssociationReq{Policies: toDetach, User: user, Group: group}); err != nil {
+			return false, err
+		}
+	}
+	return len(toAttach) > 0 || len(toDetach) > 0, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// fakePolicyAPI keeps the policies of a tenant in memory
+type fakePolicyAPI struct {
+	policies map[string][]byte
+	added    int
+}
+
+func (f *fakePolicyAPI) InfoCannedPolicyV2(_ context.Context, name string) (*madmin.PolicyInfo, error) {
+	policy, ok := f.policies[name]
+	if !ok {
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	return &madmin.PolicyInfo{PolicyName: name, Policy: policy}, nil
+}
+
+func (f *fakePolicyAPI) AddCannedPolicy(_ context.Context, name string, policy []byte) error {
+	f.policies[name] = policy
+	f.added++
+	return nil
+}
+
+func (f *fakePolicyAPI) RemoveCannedPolicy(_ context.Context, name string) error {
+	if _, ok := f.policies[name]; !ok {
+		return madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	delete(f.policies, name)
+	return nil
+}
+
+func TestSyncPolicy(t *testing.T) {
+	policy := &iamv1alpha1.MinIOPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "read-data"},
+		Spec: iamv1alpha1.MinIOPolicySpec{
+			Tenant: iamv1alpha1.TenantReference{Name: "myminio"},
+			Policy: runtime.RawExtension{Raw: []byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`)},
+		},
+	}
+	api := &fakePolicyAPI{policies: map[string][]byte{}}
+	ctx := context.Background()
+
+	if changed, err := syncPolicy(ctx, api, policy); err != nil || !changed {
+		t.Fatalf("syncPolicy() of a new policy = %v, %v", changed, err)
+	}
+	if _, ok := api.policies["read-data"]; !ok {
+		t.Fatalf("syncPolicy() didn't create the policy")
+	}
+
+	// MinIO returns the policy formatted its own way
+	api.policies["read-data"] = []byte(`{"Version": "2012-10-17", "Statement": [{"Resource": ["arn:aws:s3:::data/*"], "Action": ["s3:GetObject"], "Effect": "Allow"}]}`)
+	if changed, err := syncPolicy(ctx, api, policy); err != nil || changed {
+		t.Errorf("syncPolicy() of a policy in sync = %v, %v", changed, err)
+	}
+
+	// a policy changed outside of the MinIOPolicy resource is restored
+	api.policies["read-data"] = []byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`)
+	if changed, err := syncPolicy(ctx, api, policy); err != nil || !changed || string(api.policies["read-data"]) != string(policy.Spec.Policy.Raw) {
+		t.Errorf("syncPolicy() of a changed policy = %v, %v", changed, err)
+	}
+
+	if err := removePolicy(ctx, api, "read-data"); err != nil || len(api.policies) != 0 {
+		t.Errorf("removePolicy() = %v, policies %v", err, api.policies)
+	}
+	if err := removePolicy(ctx, api, "read-data"); err != nil {
+		t.Errorf("removePolicy() of a missing policy = %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// migratePools moves the pools with a `replaceWith` field to their replacement: the replacement pool is added to the
+// spec, once it's initialized the source pool is removed from the spec and decommissioned by checkForPoolDecommission.
+// Every step is derived from the spec and the status of the tenant, so a migration resumes after a restart of the
+// operator. Returns true if the spec of the tenant was updated.
+func (c *Controller) migratePools(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, bool, error) {
+	if len(tenant.Status.PoolMigrations) == 0 {
+		var replacing bool
+		for _, pool := range tenant.Spec.Pools {
+			if pool.ReplaceWith != nil {
+				replacing = true
+				break
+			}
+		}
+		if !replacing {
+			return tenant, false, nil
+		}
+	}
+
+	pools, migrations, problems := planPoolMigrations(tenant, metav1.Now())
+	for _, problem := range problems {
+		c.recorder.Event(tenant, corev1.EventTypeWarning, "PoolMigrationFailed", problem)
+	}
+	previous := map[string]miniov2.PoolMigrationStatus{}
+	for _, migration := range tenant.Status.PoolMigrations {
+		previous[migration.Source] = migration
+	}
+	for _, migration := range migrations {
+		if previous[migration.Source].State == migration.State {
+			continue
+		}
+		switch migration.State {
+		case miniov2.PoolMigrationCreating:
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolMigrationStarted", fmt.Sprintf("Migration of pool %s to pool %s started", migration.Source, migration.Target))
+		case miniov2.PoolMigrationDecommissioning:
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolMigrationDecommissioning", fmt.Sprintf("Pool %s is initialized, decommissioning pool %s", migration.Target, migration.Source))
+		case miniov2.PoolMigrationCompleted:
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolMigrated", fmt.Sprintf("Pool %s was replaced by pool %s", migration.Source, migration.Target))
+		}
+	}
+
+	specChanged := !equality.Semantic.DeepEqual(pools, tenant.Spec.Pools)
+	if specChanged {
+		// the tenant doesn't have the defaults applied yet, so it can be updated as is
+		updated := tenant.DeepCopy()
+		updated.Spec.Pools = pools
+		t, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, false, err
+		}
+		klog.Infof("'%s' Updated the pools of the tenant to migrate %d pools", key, len(migrations))
+		tenant = t
+	}
+	if !equality.Semantic.DeepEqual(migrations, tenant.Status.PoolMigrations) {
+		tenant.Status.PoolMigrations = migrations
+		t, err := c.updatePoolStatus(ctx, tenant)
+		if err != nil {
+			return nil, false, err
+		}
+		tenant = t
+	}
+	return tenant, specChanged, nil
+}
+
+// planPoolMigrations returns the pools of the spec and the status of the migrations after moving each migration one
+// step forward, with the reasons migrations can't make progress
+func planPoolMigrations(tenant *miniov2.Tenant, now metav1.Time) ([]miniov2.Pool, []miniov2.PoolMigrationStatus, []string) {
+	pools := make([]miniov2.Pool, 0, len(tenant.Spec.Pools))
+	for _, pool := range tenant.Spec.Pools {
+		pools = append(pools, *pool.DeepCopy())
+	}
+	previous := map[string]miniov2.PoolMigrationStatus{}
+	for _, migration := range tenant.Status.PoolMigrations {
+		previous[migration.Source] = migration
+	}
+	specPool := func(name string) int {
+		for i := range pools {
+			if pools[i].Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+	poolState := func(name string) (miniov2.PoolState, bool) {
+		ssName := tenant.PoolStatefulsetName(&miniov2.Pool{Name: name})
+		for _, pstatus := range tenant.Status.Pools {
+			if pstatus.SSName == ssName {
+				return pstatus.State, true
+			}
+		}
+		return "", false
+	}
+
+	var migrations []miniov2.PoolMigrationStatus
+	var problems []string
+	tracked := map[string]bool{}
+	for _, pool := range tenant.Spec.Pools {
+		if pool.ReplaceWith == nil {
+			continue
+		}
+		target := *pool.ReplaceWith.DeepCopy()
+		if pool.Name == "" || target.Name == "" || target.Name == pool.Name {
+			problems = append(problems, fmt.Sprintf("Pool %s can't be migrated, the pool and its replaceWith pool need different names", pool.Name))
+			continue
+		}
+		migration, ok := previous[pool.Name]
+		if !ok || migration.State == miniov2.PoolMigrationCompleted || migration.Target != target.Name {
+			migration = miniov2.PoolMigrationStatus{
+				Source:    pool.Name,
+				Target:    target.Name,
+				State:     miniov2.PoolMigrationCreating,
+				StartTime: now,
+			}
+		}
+		tracked[pool.Name] = true
+
+		if specPool(target.Name) < 0 {
+			target.ReplaceWith = nil
+			pools = append(pools, target)
+			migrations = append(migrations, migration)
+			continue
+		}
+		if state, ok := poolState(target.Name); !ok || state != miniov2.PoolInitialized {
+			migrations = append(migrations, migration)
+			continue
+		}
+		// removing a pool requires every remaining pool to have a name
+		var unnamed bool
+		for _, p := range pools {
+			if p.Name == "" {
+				unnamed = true
+				break
+			}
+		}
+		if unnamed {
+			problems = append(problems, fmt.Sprintf("Pool %s can't be decommissioned, all the pools need a name", pool.Name))
+			migrations = append(migrations, migration)
+			continue
+		}
+		source := specPool(pool.Name)
+		pools = append(pools[:source], pools[source+1:]...)
+		migration.State = miniov2.PoolMigrationDecommissioning
+		migrations = append(migrations, migration)
+	}
+
+	for _, migration := range tenant.Status.PoolMigrations {
+		if tracked[migration.Source] {
+			continue
+		}
+		switch migration.State {
+		case miniov2.PoolMigrationCompleted:
+			migrations = append(migrations, migration)
+		case miniov2.PoolMigrationDecommissioning:
+			if specPool(migration.Source) >= 0 {
+				// the source pool was added back without replaceWith, the migration is canceled
+				continue
+			}
+			if _, ok := poolState(migration.Source); !ok {
+				migration.State = miniov2.PoolMigrationCompleted
+				completionTime := now
+				migration.CompletionTime = &completionTime
+			}
+			migrations = append(migrations, migration)
+		}
+		// migrations still creating the replacement pool whose replaceWith was removed are canceled
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Source < migrations[j].Source
+	})
+	return pools, migrations, problems
+}
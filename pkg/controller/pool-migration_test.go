@@ -0,0 +1,135 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"testing"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPlanPoolMigrations(t *testing.T) {
+	now := metav1.Now()
+	source := miniov2.Pool{Name: "pool-0", Servers: 4, VolumesPerServer: 4, ReplaceWith: &miniov2.Pool{Name: "fast-0", Servers: 4, VolumesPerServer: 4}}
+	target := miniov2.Pool{Name: "fast-0", Servers: 4, VolumesPerServer: 4}
+	creating := miniov2.PoolMigrationStatus{Source: "pool-0", Target: "fast-0", State: miniov2.PoolMigrationCreating, StartTime: now}
+	decommissioning := miniov2.PoolMigrationStatus{Source: "pool-0", Target: "fast-0", State: miniov2.PoolMigrationDecommissioning, StartTime: now}
+
+	tests := []struct {
+		name         string
+		pools        []miniov2.Pool
+		poolStatus   []miniov2.PoolStatus
+		migrations   []miniov2.PoolMigrationStatus
+		wantPools    []string
+		wantState    miniov2.PoolMigrationState
+		wantProblems int
+	}{
+		{
+			name:       "Replacement pool added",
+			pools:      []miniov2.Pool{source},
+			poolStatus: []miniov2.PoolStatus{{SSName: "myminio-pool-0", State: miniov2.PoolInitialized}},
+			wantPools:  []string{"pool-0", "fast-0"},
+			wantState:  miniov2.PoolMigrationCreating,
+		},
+		{
+			name:  "Replacement pool not initialized yet",
+			pools: []miniov2.Pool{source, target},
+			poolStatus: []miniov2.PoolStatus{
+				{SSName: "myminio-pool-0", State: miniov2.PoolInitialized},
+				{SSName: "myminio-fast-0", State: miniov2.PoolCreated},
+			},
+			migrations: []miniov2.PoolMigrationStatus{creating},
+			wantPools:  []string{"pool-0", "fast-0"},
+			wantState:  miniov2.PoolMigrationCreating,
+		},
+		{
+			name:  "Source pool removed once the replacement is initialized",
+			pools: []miniov2.Pool{source, target},
+			poolStatus: []miniov2.PoolStatus{
+				{SSName: "myminio-pool-0", State: miniov2.PoolInitialized},
+				{SSName: "myminio-fast-0", State: miniov2.PoolInitialized},
+			},
+			migrations: []miniov2.PoolMigrationStatus{creating},
+			wantPools:  []string{"fast-0"},
+			wantState:  miniov2.PoolMigrationDecommissioning,
+		},
+		{
+			name:  "Source pool draining",
+			pools: []miniov2.Pool{target},
+			poolStatus: []miniov2.PoolStatus{
+				{SSName: "myminio-fast-0", State: miniov2.PoolInitialized},
+				{SSName: "myminio-pool-0", State: miniov2.PoolDecommissioning},
+			},
+			migrations: []miniov2.PoolMigrationStatus{decommissioning},
+			wantPools:  []string{"fast-0"},
+			wantState:  miniov2.PoolMigrationDecommissioning,
+		},
+		{
+			name:       "Source pool removed",
+			pools:      []miniov2.Pool{target},
+			poolStatus: []miniov2.PoolStatus{{SSName: "myminio-fast-0", State: miniov2.PoolInitialized}},
+			migrations: []miniov2.PoolMigrationStatus{decommissioning},
+			wantPools:  []string{"fast-0"},
+			wantState:  miniov2.PoolMigrationCompleted,
+		},
+		{
+			name: "Replacement pool with the same name",
+			pools: []miniov2.Pool{
+				{Name: "pool-0", Servers: 4, VolumesPerServer: 4, ReplaceWith: &miniov2.Pool{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			},
+			poolStatus:   []miniov2.PoolStatus{{SSName: "myminio-pool-0", State: miniov2.PoolInitialized}},
+			wantPools:    []string{"pool-0"},
+			wantProblems: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &miniov2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "myminio"},
+				Spec:       miniov2.TenantSpec{Pools: tt.pools},
+				Status:     miniov2.TenantStatus{Pools: tt.poolStatus, PoolMigrations: tt.migrations},
+			}
+			pools, migrations, problems := planPoolMigrations(tenant, now)
+			if len(problems) != tt.wantProblems {
+				t.Errorf("planPoolMigrations() problems = %v, want %d", problems, tt.wantProblems)
+			}
+			var names []string
+			for _, pool := range pools {
+				names = append(names, pool.Name)
+				if pool.Name == "fast-0" && pool.ReplaceWith != nil {
+					t.Errorf("planPoolMigrations() replacement pool keeps replaceWith")
+				}
+			}
+			if len(names) != len(tt.wantPools) {
+				t.Fatalf("planPoolMigrations() pools = %v, want %v", names, tt.wantPools)
+			}
+			for i := range names {
+				if names[i] != tt.wantPools[i] {
+					t.Fatalf("planPoolMigrations() pools = %v, want %v", names, tt.wantPools)
+				}
+			}
+			if tt.wantState == "" {
+				if len(migrations) != 0 {
+					t.Errorf("planPoolMigrations() migrations = %v, want none", migrations)
+				}
+				return
+			}
+			if len(migrations) != 1 || migrations[0].State != tt.wantState {
+				t.Errorf("planPoolMigrations() migrations = %v, want state %v", migrations, tt.wantState)
+			}
+		})
+	}
+}
@@ -76,7 +76,10 @@ func poolSSMatchesSpec(expectedStatefulSet, existingStatefulSet *appsv1.Stateful
 	for k, v := range expectedMetadata.Annotations {
 		expectedAnnotations[k] = v
 	}
-	currentAnnotations := existingStatefulSet.ObjectMeta.Annotations
+	currentAnnotations := map[string]string{}
+	for k, v := range existingStatefulSet.ObjectMeta.Annotations {
+		currentAnnotations[k] = v
+	}
 	delete(expectedAnnotations, corev1.LastAppliedConfigAnnotation)
 	delete(currentAnnotations, corev1.LastAppliedConfigAnnotation)
 	if !equality.Semantic.DeepEqual(expectedAnnotations, currentAnnotations) {
@@ -85,6 +88,10 @@ func poolSSMatchesSpec(expectedStatefulSet, existingStatefulSet *appsv1.Stateful
 	if miniov2.IsContainersEnvUpdated(existingStatefulSet.Spec.Template.Spec.Containers, expectedStatefulSet.Spec.Template.Spec.Containers) {
 		return false, nil
 	}
+	// the annotations already matched the hash of the generated template, the stored spec holds API server defaults
+	if _, ok := existingStatefulSet.Annotations[miniov2.PoolTemplateHashAnnotation]; ok {
+		return true, nil
+	}
 	if !equality.Semantic.DeepEqual(expectedStatefulSet.Spec, existingStatefulSet.Spec) {
 		// some field set by the operator has changed
 		return false, nil
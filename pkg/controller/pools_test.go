@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/resources/statefulsets"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -1117,3 +1118,120 @@ func Test_poolSSMatchesSpec(t *testing.T) {
 		})
 	}
 }
+
+// apiServerDefaults fills in the fields the API server defaults when a statefulset is stored
+func apiServerDefaults(ss *appsv1.StatefulSet) *appsv1.StatefulSet {
+	ss = ss.DeepCopy()
+	var revisionHistoryLimit int32 = 10
+	var gracePeriod int64 = 30
+	ss.Spec.RevisionHistoryLimit = &revisionHistoryLimit
+	ss.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+	}
+	if ss.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType && ss.Spec.UpdateStrategy.RollingUpdate == nil {
+		var partition int32
+		ss.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition}
+	}
+	podSpec := &ss.Spec.Template.Spec
+	podSpec.RestartPolicy = corev1.RestartPolicyAlways
+	podSpec.DNSPolicy = corev1.DNSClusterFirst
+	podSpec.SchedulerName = corev1.DefaultSchedulerName
+	podSpec.TerminationGracePeriodSeconds = &gracePeriod
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			containers[i].TerminationMessagePath = corev1.TerminationMessagePathDefault
+			containers[i].TerminationMessagePolicy = corev1.TerminationMessageReadFile
+			if containers[i].ImagePullPolicy == "" {
+				containers[i].ImagePullPolicy = corev1.PullIfNotPresent
+			}
+			for j := range containers[i].Ports {
+				if containers[i].Ports[j].Protocol == "" {
+					containers[i].Ports[j].Protocol = corev1.ProtocolTCP
+				}
+			}
+		}
+	}
+	for i := range ss.Spec.VolumeClaimTemplates {
+		ss.Spec.VolumeClaimTemplates[i].Status.Phase = corev1.ClaimPending
+	}
+	return ss
+}
+
+func Test_poolSSMatchesSpecDefaulted(t *testing.T) {
+	newTenant := func(image string) *miniov2.Tenant {
+		tenant := &miniov2.Tenant{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns"},
+			Spec: miniov2.TenantSpec{
+				Image:         image,
+				Configuration: &corev1.LocalObjectReference{Name: "tenant-env-configuration"},
+				Pools: []miniov2.Pool{{
+					Name:             "pool-0",
+					Servers:          4,
+					VolumesPerServer: 1,
+					VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "data"},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+							},
+						},
+					},
+				}},
+			},
+		}
+		tenant.EnsureDefaults()
+		return tenant
+	}
+	newPool := func(tenant *miniov2.Tenant) *appsv1.StatefulSet {
+		return statefulsets.NewPool(&statefulsets.NewPoolArgs{
+			Tenant:      tenant,
+			Pool:        &tenant.Spec.Pools[0],
+			PoolStatus:  &miniov2.PoolStatus{SSName: tenant.PoolStatefulsetName(&tenant.Spec.Pools[0])},
+			ServiceName: tenant.MinIOHLServiceName(),
+		})
+	}
+	stored := apiServerDefaults(newPool(newTenant("minio/minio:RELEASE.2024-01-01T00-00-00Z")))
+	legacy := stored.DeepCopy()
+	delete(legacy.Annotations, miniov2.PoolTemplateHashAnnotation)
+
+	tests := []struct {
+		name     string
+		expected *appsv1.StatefulSet
+		existing *appsv1.StatefulSet
+		want     bool
+	}{
+		{
+			name:     "Defaulted statefulset unchanged",
+			expected: newPool(newTenant("minio/minio:RELEASE.2024-01-01T00-00-00Z")),
+			existing: stored,
+			want:     true,
+		},
+		{
+			name:     "Defaulted statefulset with a new image",
+			expected: newPool(newTenant("minio/minio:RELEASE.2024-02-01T00-00-00Z")),
+			existing: stored,
+			want:     false,
+		},
+		{
+			name:     "Defaulted statefulset without the template hash",
+			expected: newPool(newTenant("minio/minio:RELEASE.2024-01-01T00-00-00Z")),
+			existing: legacy,
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := poolSSMatchesSpec(tt.expected, tt.existing)
+			if err != nil {
+				t.Fatalf("poolSSMatchesSpec() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("poolSSMatchesSpec() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
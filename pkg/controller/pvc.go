@@ -2,43 +2,342 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 )
 
-// ExpandPVCs expands the PVCs for a given tenant
-func ExpandPVCs(ctx context.Context, kubeClientSet kubernetes.Interface, tenant *miniov2.Tenant, namespace string) error {
+// VolumeExpansionNotAllowedError is returned when a PVC has to be expanded but its StorageClass doesn't allow it
+type VolumeExpansionNotAllowedError struct {
+	PVC          string
+	StorageClass string
+}
+
+func (e *VolumeExpansionNotAllowedError) Error() string {
+	if e.StorageClass == "" {
+		return fmt.Sprintf("PVC %s can't be expanded, it has no StorageClass", e.PVC)
+	}
+	return fmt.Sprintf("PVC %s can't be expanded, StorageClass %s doesn't allow volume expansion", e.PVC, e.StorageClass)
+}
+
+// PVCResizeEvent is a resize of a PVC that completed or failed
+type PVCResizeEvent struct {
+	Pool      string
+	Resize    miniov2.PVCResizeStatus
+	Completed bool
+}
+
+// ExpandPVCs expands the PVCs for a given tenant, and tracks the progress of the resize in the status of the pools.
+// No PVC is expanded if the StorageClass of any of the PVCs to expand doesn't allow volume expansion.
+func ExpandPVCs(ctx context.Context, kubeClientSet kubernetes.Interface, tenant *miniov2.Tenant, namespace string) ([]PVCResizeEvent, error) {
 	uOpts := metav1.UpdateOptions{}
 
-	for _, pool := range tenant.Spec.Pools {
+	pvcs, toExpand, err := listPVCsToExpand(ctx, kubeClientSet, tenant, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate all the PVCs can be expanded before expanding any of them
+	expandable := map[string]bool{}
+	for _, pvc := range toExpand {
+		className := ""
+		if pvc.Spec.StorageClassName != nil {
+			className = *pvc.Spec.StorageClassName
+		}
+		allowed, ok := expandable[className]
+		if !ok && className != "" {
+			class, err := kubeClientSet.StorageV1().StorageClasses().Get(ctx, className, metav1.GetOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return nil, err
+			}
+			allowed = err == nil && class.AllowVolumeExpansion != nil && *class.AllowVolumeExpansion
+			expandable[className] = allowed
+		}
+		if !allowed {
+			return nil, &VolumeExpansionNotAllowedError{PVC: pvc.Name, StorageClass: className}
+		}
+	}
+
+	for i, pool := range tenant.Spec.Pools {
+		if !pool.HasVolumeClaimTemplate() {
+			continue
+		}
+		for j, pvc := range pvcs[i] {
+			requestedStorage, ok := requestedPVCStorage(tenant, &pool, pvc.Name)
+			currentStorage := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			if ok && requestedStorage.Cmp(currentStorage) > 0 {
+				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = requestedStorage
+				updated, err := kubeClientSet.CoreV1().PersistentVolumeClaims(namespace).Update(ctx, &pvc, uOpts)
+				if err != nil {
+					return nil, err
+				}
+				pvcs[i][j] = *updated
+				klog.Infof("Expanded PVC %s from %s to %s", pvc.Name, currentStorage.String(), requestedStorage.String())
+			}
+		}
+	}
+
+	var events []PVCResizeEvent
+	for i, pool := range tenant.Spec.Pools {
+		if !pool.HasVolumeClaimTemplate() {
+			continue
+		}
+		var poolStatus *miniov2.PoolStatus
+		for k := range tenant.Status.Pools {
+			if tenant.Status.Pools[k].SSName == tenant.PoolStatefulsetName(&pool) {
+				poolStatus = &tenant.Status.Pools[k]
+			}
+		}
+		if poolStatus == nil {
+			continue
+		}
+		previous := map[string]miniov2.PVCResizeStatus{}
+		for _, resize := range poolStatus.VolumeResizes {
+			previous[resize.Name] = resize
+		}
+		var resizes []miniov2.PVCResizeStatus
+		for _, pvc := range pvcs[i] {
+			resize, resizing := pvcResizeStatus(&pvc)
+			prev, tracked := previous[pvc.Name]
+			if !resizing {
+				if tracked {
+					events = append(events, PVCResizeEvent{Pool: pool.Name, Resize: prev, Completed: true})
+				}
+				continue
+			}
+			if resize.State == miniov2.PVCResizeFailed && prev.State != miniov2.PVCResizeFailed {
+				events = append(events, PVCResizeEvent{Pool: pool.Name, Resize: resize})
+			}
+			resizes = append(resizes, resize)
+		}
+		sort.Slice(resizes, func(a, b int) bool {
+			return resizes[a].Name < resizes[b].Name
+		})
+		poolStatus.VolumeResizes = resizes
+	}
+	return events, nil
+}
+
+// listPVCsToExpand returns the PVCs of each pool of the tenant, and the PVCs smaller than requested by their template
+func listPVCsToExpand(ctx context.Context, kubeClientSet kubernetes.Interface, tenant *miniov2.Tenant, namespace string) ([][]corev1.PersistentVolumeClaim, []*corev1.PersistentVolumeClaim, error) {
+	pvcs := make([][]corev1.PersistentVolumeClaim, len(tenant.Spec.Pools))
+	var toExpand []*corev1.PersistentVolumeClaim
+	for i, pool := range tenant.Spec.Pools {
+		if !pool.HasVolumeClaimTemplate() {
+			continue
+		}
 		opts := metav1.ListOptions{
 			LabelSelector: fmt.Sprintf("%s=%s,%s=%s", miniov2.TenantLabel, tenant.Name, miniov2.PoolLabel, pool.Name),
 		}
 		pvcList, err := kubeClientSet.CoreV1().PersistentVolumeClaims(namespace).List(ctx, opts)
 		if err != nil {
-			return err
-		}
-
-		for _, pvc := range pvcList.Items {
-			if pool.VolumeClaimTemplate != nil {
-				requestedStorage := pool.VolumeClaimTemplate.Spec.Resources.Requests[corev1.ResourceStorage]
-				currentStorage := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
-				if requestedStorage.Cmp(currentStorage) > 0 {
-					pvc.Spec.Resources.Requests[corev1.ResourceStorage] = requestedStorage
-					_, err = kubeClientSet.CoreV1().PersistentVolumeClaims(namespace).Update(ctx, &pvc, uOpts)
-					if err != nil {
-						return err
-					}
-					klog.Infof("Expanded PVC %s from %s to %s", pvc.Name, currentStorage.String(), requestedStorage.String())
-				}
+			return nil, nil, err
+		}
+		pvcs[i] = pvcList.Items
+
+		for j := range pvcs[i] {
+			requestedStorage, ok := requestedPVCStorage(tenant, &pool, pvcs[i][j].Name)
+			currentStorage := pvcs[i][j].Spec.Resources.Requests[corev1.ResourceStorage]
+			if ok && requestedStorage.Cmp(currentStorage) > 0 {
+				toExpand = append(toExpand, &pvcs[i][j])
+			}
+		}
+	}
+	return pvcs, toExpand, nil
+}
+
+// requestedPVCStorage returns the storage requested by the template a PVC of the pool was generated from. Pools with
+// several templates tell the template apart by the name of the PVC, <template name><volume>-<statefulset>-<ordinal>.
+func requestedPVCStorage(tenant *miniov2.Tenant, pool *miniov2.Pool, pvcName string) (resource.Quantity, bool) {
+	if len(pool.VolumeClaimTemplates) == 0 {
+		if pool.VolumeClaimTemplate == nil {
+			return resource.Quantity{}, false
+		}
+		return pool.VolumeClaimTemplate.Spec.Resources.Requests[corev1.ResourceStorage], true
+	}
+	ssName := tenant.PoolStatefulsetName(pool)
+	for i := 0; i < int(pool.VolumesPerServer); i++ {
+		template := pool.VolumeClaimTemplateFor(i)
+		if template == nil {
+			break
+		}
+		if strings.HasPrefix(pvcName, fmt.Sprintf("%s%d-%s-", template.Name, i, ssName)) {
+			return template.Spec.Resources.Requests[corev1.ResourceStorage], true
+		}
+	}
+	return resource.Quantity{}, false
+}
+
+// pvcResizeStatus returns the progress of the resize of a bound PVC, and whether it's still being resized
+func pvcResizeStatus(pvc *corev1.PersistentVolumeClaim) (miniov2.PVCResizeStatus, bool) {
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	resize := miniov2.PVCResizeStatus{
+		Name:      pvc.Name,
+		Requested: requested,
+		Capacity:  capacity,
+		State:     miniov2.PVCResizePending,
+	}
+	if pvc.Status.Phase != corev1.ClaimBound || requested.Cmp(capacity) <= 0 {
+		return resize, false
+	}
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case corev1.PersistentVolumeClaimResizing:
+			resize.State = miniov2.PVCResizeResizing
+			resize.Message = condition.Message
+		case corev1.PersistentVolumeClaimFileSystemResizePending:
+			resize.State = miniov2.PVCResizeFileSystemResizePending
+			resize.Message = condition.Message
+		}
+	}
+	switch pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage] {
+	case corev1.PersistentVolumeClaimControllerResizeFailed, corev1.PersistentVolumeClaimNodeResizeFailed:
+		resize.State = miniov2.PVCResizeFailed
+	}
+	return resize, true
+}
+
+// expandPoolVolumes expands the PVCs of the tenant, reports the progress on the tenant status and recreates the
+// statefulsets whose volumeClaimTemplate is smaller than the one of the pool, so new replicas get the new size.
+// It returns true when a statefulset is being recreated.
+func (c *Controller) expandPoolVolumes(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, bool, error) {
+	if tenant.HasMaintenanceWindows() {
+		_, toExpand, err := listPVCsToExpand(ctx, c.kubeClientSet, tenant, tenant.Namespace)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(toExpand) > 0 {
+			var allowed bool
+			if tenant, allowed, err = c.checkMaintenanceWindow(ctx, key, tenant, miniov2.MaintenanceVolumeExpansion, fmt.Sprintf("Expand %d PVCs", len(toExpand))); err != nil {
+				return nil, false, err
+			}
+			if !allowed {
+				return tenant, false, nil
 			}
 		}
 	}
+	updated := tenant.DeepCopy()
+	events, err := ExpandPVCs(ctx, c.kubeClientSet, updated, tenant.Namespace)
+	if err != nil {
+		var notAllowed *VolumeExpansionNotAllowedError
+		if !errors.As(err, &notAllowed) {
+			return nil, false, err
+		}
+		msg := err.Error()
+		condition := meta.FindStatusCondition(tenant.Status.Conditions, miniov2.TenantConditionVolumeExpansionInProgress)
+		if condition != nil && condition.Reason == VolumeExpansionNotAllowedReason && condition.Message == msg {
+			return tenant, false, nil
+		}
+		klog.Warningf("'%s' %s", key, msg)
+		c.recorder.Event(tenant, corev1.EventTypeWarning, VolumeExpansionNotAllowedReason, msg)
+		conditions := append(degradedTenantConditions(VolumeExpansionNotAllowedReason, msg),
+			newTenantCondition(miniov2.TenantConditionVolumeExpansionInProgress, metav1.ConditionFalse, VolumeExpansionNotAllowedReason, msg))
+		tenant, err = c.updateTenantConditions(ctx, tenant, conditions...)
+		return tenant, false, err
+	}
 
-	return nil
+	for _, event := range events {
+		if event.Completed {
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "PVCResized", fmt.Sprintf("PVC %s of pool %s resized to %s", event.Resize.Name, event.Pool, event.Resize.Requested.String()))
+		} else {
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "PVCResizeFailed", fmt.Sprintf("PVC %s of pool %s failed to resize to %s", event.Resize.Name, event.Pool, event.Resize.Requested.String()))
+		}
+	}
+
+	// the volumes can be expanded, clear a previous rejection
+	if degraded := meta.FindStatusCondition(updated.Status.Conditions, miniov2.TenantConditionDegraded); degraded != nil && degraded.Reason == VolumeExpansionNotAllowedReason {
+		setTenantConditions(updated, newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionFalse, VolumeExpandingReason, "The PVCs can be expanded"))
+	}
+	setVolumeExpansionConditions(updated)
+	if !equality.Semantic.DeepEqual(tenant.Status, updated.Status) {
+		if tenant, err = c.updatePoolStatus(ctx, updated); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// the volumeClaimTemplates of a statefulset can't be updated, delete it leaving its pods running, it's
+	// created again with the new volumeClaimTemplate in the next sync
+	recreating := false
+	for _, pool := range tenant.Spec.Pools {
+		if !pool.HasVolumeClaimTemplate() {
+			continue
+		}
+		ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(tenant.PoolStatefulsetName(&pool))
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, false, err
+		}
+		if ss.DeletionTimestamp != nil {
+			recreating = true
+			continue
+		}
+		if len(ss.Spec.VolumeClaimTemplates) == 0 || !metav1.IsControlledBy(ss, tenant) {
+			continue
+		}
+		var requested resource.Quantity
+		for i := range ss.Spec.VolumeClaimTemplates {
+			template := pool.VolumeClaimTemplateFor(i)
+			if i >= int(pool.VolumesPerServer) || template == nil {
+				break
+			}
+			current := ss.Spec.VolumeClaimTemplates[i].Spec.Resources.Requests[corev1.ResourceStorage]
+			if r := template.Spec.Resources.Requests[corev1.ResourceStorage]; r.Cmp(current) > 0 {
+				requested = r
+				break
+			}
+		}
+		if requested.IsZero() {
+			continue
+		}
+		klog.Infof("'%s' Recreating statefulset %s with a %s volumeClaimTemplate", key, ss.Name, requested.String())
+		orphan := metav1.DeletePropagationOrphan
+		if err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Delete(ctx, ss.Name, metav1.DeleteOptions{PropagationPolicy: &orphan}); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, false, err
+		}
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolVolumeClaimTemplateUpdated", fmt.Sprintf("Recreating the statefulset of pool %s to request %s volumes, pods keep running", pool.Name, requested.String()))
+		recreating = true
+	}
+	return tenant, recreating, nil
+}
+
+// setVolumeExpansionConditions reflects the PVCs being resized on the VolumeExpansionInProgress condition
+func setVolumeExpansionConditions(tenant *miniov2.Tenant) {
+	var resizing, failed []string
+	for _, pool := range tenant.Status.Pools {
+		for _, resize := range pool.VolumeResizes {
+			if resize.State == miniov2.PVCResizeFailed {
+				failed = append(failed, resize.Name)
+			} else {
+				resizing = append(resizing, resize.Name)
+			}
+		}
+	}
+	condition := meta.FindStatusCondition(tenant.Status.Conditions, miniov2.TenantConditionVolumeExpansionInProgress)
+	switch {
+	case len(failed) > 0:
+		setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionVolumeExpansionInProgress, metav1.ConditionFalse, VolumeExpansionFailedReason,
+			fmt.Sprintf("Failed to resize PVCs %v", failed)))
+	case len(resizing) > 0:
+		setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionVolumeExpansionInProgress, metav1.ConditionTrue, VolumeExpandingReason,
+			fmt.Sprintf("Resizing %d PVCs", len(resizing))))
+	case condition != nil && condition.Reason != VolumeExpansionCompletedReason:
+		setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionVolumeExpansionInProgress, metav1.ConditionFalse, VolumeExpansionCompletedReason, "All PVCs are resized"))
+	}
 }
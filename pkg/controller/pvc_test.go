@@ -2,17 +2,28 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
+// expandableStorageClass returns a StorageClass that allows volume expansion or not
+func expandableStorageClass(name string, allowVolumeExpansion bool) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: name},
+		AllowVolumeExpansion: &allowVolumeExpansion,
+	}
+}
+
 func TestExpandPVCs(t *testing.T) {
 	ctx := context.Background()
+	storageClass := "expandable"
 
 	tenant := &miniov2.Tenant{
 		ObjectMeta: metav1.ObjectMeta{
@@ -46,6 +57,7 @@ func TestExpandPVCs(t *testing.T) {
 			},
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: &storageClass,
 			Resources: corev1.VolumeResourceRequirements{
 				Requests: corev1.ResourceList{
 					corev1.ResourceStorage: resource.MustParse("1Gi"),
@@ -54,9 +66,9 @@ func TestExpandPVCs(t *testing.T) {
 		},
 	}
 
-	kubeClient := fake.NewSimpleClientset(pvc)
+	kubeClient := fake.NewSimpleClientset(pvc, expandableStorageClass(storageClass, true))
 
-	err := ExpandPVCs(ctx, kubeClient, tenant, "test-namespace")
+	_, err := ExpandPVCs(ctx, kubeClient, tenant, "test-namespace")
 	if err != nil {
 		t.Fatalf("ExpandPVCs failed: %v", err)
 	}
@@ -70,3 +82,147 @@ func TestExpandPVCs(t *testing.T) {
 		t.Errorf("Expected PVC storage to be 2Gi, but got %v", updatedPVC.Spec.Resources.Requests[corev1.ResourceStorage])
 	}
 }
+
+func TestExpandPVCs_NotAllowed(t *testing.T) {
+	ctx := context.Background()
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-tenant",
+		},
+		Spec: miniov2.TenantSpec{
+			Pools: []miniov2.Pool{
+				{
+					Name: "pool1",
+					VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+						Spec: corev1.PersistentVolumeClaimSpec{
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: resource.MustParse("2Gi"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	newPVC := func(name, storageClass string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels: map[string]string{
+					miniov2.TenantLabel: tenant.Name,
+					miniov2.PoolLabel:   "pool1",
+				},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &storageClass,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		}
+	}
+
+	// one of the PVCs can be expanded, the other one can't
+	kubeClient := fake.NewSimpleClientset(
+		newPVC("data-0", "expandable"),
+		newPVC("data-1", "fixed"),
+		expandableStorageClass("expandable", true),
+		expandableStorageClass("fixed", false),
+	)
+
+	_, err := ExpandPVCs(ctx, kubeClient, tenant, "test-namespace")
+	var notAllowed *VolumeExpansionNotAllowedError
+	if !errors.As(err, &notAllowed) {
+		t.Fatalf("Expected a VolumeExpansionNotAllowedError, got %v", err)
+	}
+	if notAllowed.PVC != "data-1" || notAllowed.StorageClass != "fixed" {
+		t.Errorf("Expected PVC data-1 with StorageClass fixed to be rejected, got %s", notAllowed.Error())
+	}
+
+	pvc, err := kubeClient.CoreV1().PersistentVolumeClaims("test-namespace").Get(ctx, "data-0", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get PVC: %v", err)
+	}
+	if pvc.Spec.Resources.Requests[corev1.ResourceStorage] != resource.MustParse("1Gi") {
+		t.Errorf("Expected PVC data-0 not to be expanded, but got %v", pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	}
+}
+
+func TestPVCResizeStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		capacity     string
+		conditions   []corev1.PersistentVolumeClaimCondition
+		allocated    corev1.ClaimResourceStatus
+		wantResizing bool
+		wantState    miniov2.PVCResizeState
+	}{
+		{
+			name:     "Resized",
+			capacity: "2Gi",
+		},
+		{
+			name:         "Pending",
+			capacity:     "1Gi",
+			wantResizing: true,
+			wantState:    miniov2.PVCResizePending,
+		},
+		{
+			name:     "Resizing",
+			capacity: "1Gi",
+			conditions: []corev1.PersistentVolumeClaimCondition{
+				{Type: corev1.PersistentVolumeClaimResizing, Status: corev1.ConditionTrue},
+			},
+			wantResizing: true,
+			wantState:    miniov2.PVCResizeResizing,
+		},
+		{
+			name:     "File system resize pending",
+			capacity: "1Gi",
+			conditions: []corev1.PersistentVolumeClaimCondition{
+				{Type: corev1.PersistentVolumeClaimFileSystemResizePending, Status: corev1.ConditionTrue},
+			},
+			wantResizing: true,
+			wantState:    miniov2.PVCResizeFileSystemResizePending,
+		},
+		{
+			name:         "Failed",
+			capacity:     "1Gi",
+			allocated:    corev1.PersistentVolumeClaimControllerResizeFailed,
+			wantResizing: true,
+			wantState:    miniov2.PVCResizeFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "data-0"},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+					},
+				},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Phase:      corev1.ClaimBound,
+					Capacity:   corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(tt.capacity)},
+					Conditions: tt.conditions,
+				},
+			}
+			if tt.allocated != "" {
+				pvc.Status.AllocatedResourceStatuses = map[corev1.ResourceName]corev1.ClaimResourceStatus{corev1.ResourceStorage: tt.allocated}
+			}
+			resize, resizing := pvcResizeStatus(pvc)
+			if resizing != tt.wantResizing {
+				t.Fatalf("pvcResizeStatus() resizing = %v, want %v", resizing, tt.wantResizing)
+			}
+			if resizing && resize.State != tt.wantState {
+				t.Errorf("pvcResizeStatus() state = %v, want %v", resize.State, tt.wantState)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// checkForRebalance starts, follows or stops the rebalance of the data across the pools of the tenant.
+// It's expected to be called once all the pools are initialized.
+func (c *Controller) checkForRebalance(ctx context.Context, key string, tenant *miniov2.Tenant, tenantConfiguration map[string][]byte) (*miniov2.Tenant, error) {
+	if !tenant.RebalanceInProgress() {
+		return tenant, nil
+	}
+	rebalance := tenant.Status.Rebalance
+	if !tenant.HasRebalanceEnabled() && rebalance.State != miniov2.RebalanceRunning {
+		now := metav1.Now()
+		rebalance.State = miniov2.RebalanceStopped
+		rebalance.StoppedAt = &now
+		rebalance.NextRetryTime = nil
+		return c.updatePoolStatus(ctx, tenant)
+	}
+	// a failed rebalance is left alone until it's due to be retried
+	if rebalance.State == miniov2.RebalanceFailed && rebalance.NextRetryTime != nil && time.Now().Before(rebalance.NextRetryTime.Time) {
+		return tenant, nil
+	}
+	adminClnt, err := tenant.NewMinIOAdmin(tenantConfiguration, c.getTransport())
+	if err != nil {
+		return tenant, err
+	}
+
+	switch rebalance.State {
+	case miniov2.RebalancePending, miniov2.RebalanceFailed:
+		if rebalance.State == miniov2.RebalanceFailed {
+			rebalance.Retries++
+		}
+		id, err := adminClnt.RebalanceStart(ctx)
+		if err != nil {
+			c.rebalanceFailed(tenant, fmt.Sprintf("Rebalance failed to start: %s", err))
+			break
+		}
+		klog.Infof("'%s' Started rebalance %s", key, id)
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "RebalanceStarted", "Rebalance of the data across the pools started")
+		now := metav1.Now()
+		rebalance.ID = id
+		rebalance.State = miniov2.RebalanceRunning
+		rebalance.StartTime = &now
+		rebalance.StoppedAt = nil
+		rebalance.NextRetryTime = nil
+		rebalance.Message = ""
+	case miniov2.RebalanceRunning:
+		if !tenant.HasRebalanceEnabled() {
+			if err = adminClnt.RebalanceStop(ctx); err != nil {
+				c.recorder.Event(tenant, corev1.EventTypeWarning, "RebalanceFailed", fmt.Sprintf("Rebalance failed to stop: %s", err))
+				return tenant, err
+			}
+			klog.Infof("'%s' Stopped rebalance %s", key, rebalance.ID)
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "RebalanceStopped", "Rebalance of the data across the pools stopped")
+			now := metav1.Now()
+			rebalance.State = miniov2.RebalanceStopped
+			rebalance.StoppedAt = &now
+			break
+		}
+		status, err := adminClnt.RebalanceStatus(ctx)
+		if err != nil {
+			c.recorder.Event(tenant, corev1.EventTypeWarning, "RebalanceFailed", fmt.Sprintf("Unable to get the rebalance status: %s", err))
+			return tenant, fmt.Errorf("unable to get the rebalance status: %w", err)
+		}
+		switch setRebalanceStatus(rebalance, status) {
+		case miniov2.RebalanceCompleted:
+			klog.Infof("'%s' Rebalance %s completed", key, rebalance.ID)
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "RebalanceCompleted", "Rebalance of the data across the pools completed")
+			rebalance.State = miniov2.RebalanceCompleted
+		case miniov2.RebalanceStopped:
+			// stopped in MinIO, for example with `mc admin rebalance stop`, so it's not restarted
+			klog.Infof("'%s' Rebalance %s was stopped in MinIO", key, rebalance.ID)
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "RebalanceStopped", "Rebalance of the data across the pools was stopped in MinIO")
+			rebalance.State = miniov2.RebalanceStopped
+		case miniov2.RebalanceFailed:
+			c.rebalanceFailed(tenant, fmt.Sprintf("Rebalance %s failed in MinIO", rebalance.ID))
+		}
+	}
+	return c.updatePoolStatus(ctx, tenant)
+}
+
+// rebalanceFailed records the failure of the rebalance in the tenant status, and schedules its retry
+func (c *Controller) rebalanceFailed(tenant *miniov2.Tenant, message string) {
+	rebalance := tenant.Status.Rebalance
+	next := metav1.NewTime(time.Now().Add(retryBackoff(rebalance.Retries)))
+	rebalance.State = miniov2.RebalanceFailed
+	rebalance.NextRetryTime = &next
+	rebalance.Message = message
+	c.recorder.Event(tenant, corev1.EventTypeWarning, "RebalanceFailed", fmt.Sprintf("%s, retrying at %s", message, next.UTC().Format(time.RFC3339)))
+}
+
+// setRebalanceStatus copies the rebalance progress reported by MinIO into the tenant status, and returns the state of
+// the rebalance according to the status of its pools: Running while any pool is being rebalanced, then Failed or
+// Stopped if any pool failed or was stopped, and Completed otherwise
+func setRebalanceStatus(rebalance *miniov2.RebalanceStatus, status madmin.RebalanceStatus) miniov2.RebalanceState {
+	if status.ID != "" {
+		rebalance.ID = status.ID
+	}
+	if !status.StoppedAt.IsZero() {
+		stoppedAt := metav1.NewTime(status.StoppedAt)
+		rebalance.StoppedAt = &stoppedAt
+	}
+	var running, failed, stopped, completed bool
+	rebalance.Pools = nil
+	for _, pool := range status.Pools {
+		rebalance.Pools = append(rebalance.Pools, miniov2.RebalancePoolStatus{
+			ID:             int32(pool.ID),
+			Status:         pool.Status,
+			UsedPercentage: int32(pool.Used * 100),
+			Objects:        int64(pool.Progress.NumObjects),
+			Versions:       int64(pool.Progress.NumVersions),
+			Bytes:          int64(pool.Progress.Bytes),
+		})
+		switch pool.Status {
+		case "Started", "Active":
+			running = true
+		case "Failed":
+			failed = true
+		case "Stopped":
+			stopped = true
+		case "Completed":
+			completed = true
+		}
+	}
+	switch {
+	case running:
+		return miniov2.RebalanceRunning
+	case failed:
+		return miniov2.RebalanceFailed
+	case stopped:
+		return miniov2.RebalanceStopped
+	case completed, !status.StoppedAt.IsZero():
+		return miniov2.RebalanceCompleted
+	}
+	// MinIO didn't pick up the rebalance yet
+	return miniov2.RebalanceRunning
+}
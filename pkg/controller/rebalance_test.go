@@ -0,0 +1,346 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_setRebalanceStatus(t *testing.T) {
+	stoppedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	pools := func(statuses ...string) []madmin.RebalancePoolStatus {
+		var pools []madmin.RebalancePoolStatus
+		for i, status := range statuses {
+			pools = append(pools, madmin.RebalancePoolStatus{ID: i, Status: status, Used: 0.5, Progress: madmin.RebalPoolProgress{NumObjects: 10, NumVersions: 12, Bytes: 1024}})
+		}
+		return pools
+	}
+	tests := []struct {
+		name   string
+		status madmin.RebalanceStatus
+		want   miniov2.RebalanceState
+	}{
+		{
+			name:   "Rebalance not picked up by MinIO yet",
+			status: madmin.RebalanceStatus{ID: "id"},
+			want:   miniov2.RebalanceRunning,
+		},
+		{
+			name:   "Pool being rebalanced",
+			status: madmin.RebalanceStatus{ID: "id", Pools: pools("Started", "None")},
+			want:   miniov2.RebalanceRunning,
+		},
+		{
+			name:   "Pool reported active",
+			status: madmin.RebalanceStatus{ID: "id", Pools: pools("Completed", "Active")},
+			want:   miniov2.RebalanceRunning,
+		},
+		{
+			name:   "All pools completed",
+			status: madmin.RebalanceStatus{ID: "id", StoppedAt: stoppedAt, Pools: pools("Completed", "None")},
+			want:   miniov2.RebalanceCompleted,
+		},
+		{
+			name:   "Stopped without pool status",
+			status: madmin.RebalanceStatus{ID: "id", StoppedAt: stoppedAt, Pools: pools("", "")},
+			want:   miniov2.RebalanceCompleted,
+		},
+		{
+			name:   "Pool stopped",
+			status: madmin.RebalanceStatus{ID: "id", StoppedAt: stoppedAt, Pools: pools("Completed", "Stopped")},
+			want:   miniov2.RebalanceStopped,
+		},
+		{
+			name:   "Pool failed",
+			status: madmin.RebalanceStatus{ID: "id", StoppedAt: stoppedAt, Pools: pools("Stopped", "Failed")},
+			want:   miniov2.RebalanceFailed,
+		},
+		{
+			name:   "Pool failed while another is still rebalanced",
+			status: madmin.RebalanceStatus{ID: "id", Pools: pools("Started", "Failed")},
+			want:   miniov2.RebalanceRunning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rebalance := &miniov2.RebalanceStatus{ID: "previous", State: miniov2.RebalanceRunning}
+			if got := setRebalanceStatus(rebalance, tt.status); got != tt.want {
+				t.Errorf("setRebalanceStatus() = %s, want %s", got, tt.want)
+			}
+			if rebalance.ID != "id" {
+				t.Errorf("ID = %s, want id", rebalance.ID)
+			}
+			if tt.status.StoppedAt.IsZero() != (rebalance.StoppedAt == nil) {
+				t.Errorf("StoppedAt = %v, want %v", rebalance.StoppedAt, tt.status.StoppedAt)
+			}
+			if len(rebalance.Pools) != len(tt.status.Pools) {
+				t.Fatalf("got %d pools, want %d", len(rebalance.Pools), len(tt.status.Pools))
+			}
+			for i, pool := range rebalance.Pools {
+				if pool.ID != int32(i) || pool.Status != tt.status.Pools[i].Status || pool.UsedPercentage != 50 || pool.Objects != 10 || pool.Versions != 12 || pool.Bytes != 1024 {
+					t.Errorf("pool %d = %+v", i, pool)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckForRebalance(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Minute))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	tests := []struct {
+		name        string
+		disabled    bool
+		rebalance   miniov2.RebalanceStatus
+		startErr    bool
+		poolStatus  string
+		wantCalls   []string
+		wantState   miniov2.RebalanceState
+		wantRetries int32
+		wantRetry   bool
+		wantEvents  []string
+	}{
+		{
+			name:       "Pending rebalance starts",
+			rebalance:  miniov2.RebalanceStatus{State: miniov2.RebalancePending},
+			wantCalls:  []string{"start"},
+			wantState:  miniov2.RebalanceRunning,
+			wantEvents: []string{"RebalanceStarted"},
+		},
+		{
+			name:      "Pending rebalance disabled in the spec",
+			disabled:  true,
+			rebalance: miniov2.RebalanceStatus{State: miniov2.RebalancePending},
+			wantState: miniov2.RebalanceStopped,
+		},
+		{
+			name:       "Rebalance refused by MinIO is recorded and retried later",
+			rebalance:  miniov2.RebalanceStatus{State: miniov2.RebalancePending},
+			startErr:   true,
+			wantCalls:  []string{"start"},
+			wantState:  miniov2.RebalanceFailed,
+			wantRetry:  true,
+			wantEvents: []string{"RebalanceFailed"},
+		},
+		{
+			name:      "Failed rebalance waits for its retry",
+			rebalance: miniov2.RebalanceStatus{State: miniov2.RebalanceFailed, NextRetryTime: &future},
+			wantState: miniov2.RebalanceFailed,
+			wantRetry: true,
+		},
+		{
+			name:        "Failed rebalance is retried",
+			rebalance:   miniov2.RebalanceStatus{State: miniov2.RebalanceFailed, NextRetryTime: &past, Message: "failed"},
+			wantCalls:   []string{"start"},
+			wantState:   miniov2.RebalanceRunning,
+			wantRetries: 1,
+			wantEvents:  []string{"RebalanceStarted"},
+		},
+		{
+			name:        "Failed retry backs off again",
+			rebalance:   miniov2.RebalanceStatus{State: miniov2.RebalanceFailed, NextRetryTime: &past, Retries: 2},
+			startErr:    true,
+			wantCalls:   []string{"start"},
+			wantState:   miniov2.RebalanceFailed,
+			wantRetries: 3,
+			wantRetry:   true,
+			wantEvents:  []string{"RebalanceFailed"},
+		},
+		{
+			name:       "Running rebalance reports its progress",
+			rebalance:  miniov2.RebalanceStatus{ID: "id", State: miniov2.RebalanceRunning},
+			poolStatus: "Started",
+			wantCalls:  []string{"status"},
+			wantState:  miniov2.RebalanceRunning,
+		},
+		{
+			name:       "Running rebalance completed",
+			rebalance:  miniov2.RebalanceStatus{ID: "id", State: miniov2.RebalanceRunning},
+			poolStatus: "Completed",
+			wantCalls:  []string{"status"},
+			wantState:  miniov2.RebalanceCompleted,
+			wantEvents: []string{"RebalanceCompleted"},
+		},
+		{
+			name:       "Running rebalance stopped in MinIO",
+			rebalance:  miniov2.RebalanceStatus{ID: "id", State: miniov2.RebalanceRunning},
+			poolStatus: "Stopped",
+			wantCalls:  []string{"status"},
+			wantState:  miniov2.RebalanceStopped,
+			wantEvents: []string{"RebalanceStopped"},
+		},
+		{
+			name:       "Running rebalance failed in MinIO",
+			rebalance:  miniov2.RebalanceStatus{ID: "id", State: miniov2.RebalanceRunning},
+			poolStatus: "Failed",
+			wantCalls:  []string{"status"},
+			wantState:  miniov2.RebalanceFailed,
+			wantRetry:  true,
+			wantEvents: []string{"RebalanceFailed"},
+		},
+		{
+			name:       "Running rebalance disabled in the spec",
+			disabled:   true,
+			rebalance:  miniov2.RebalanceStatus{ID: "id", State: miniov2.RebalanceRunning},
+			wantCalls:  []string{"stop"},
+			wantState:  miniov2.RebalanceStopped,
+			wantEvents: []string{"RebalanceStopped"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/rebalance/start"):
+					calls = append(calls, "start")
+					if tt.startErr {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					json.NewEncoder(w).Encode(map[string]string{"id": "id"})
+				case strings.HasSuffix(r.URL.Path, "/rebalance/status"):
+					calls = append(calls, "status")
+					status := madmin.RebalanceStatus{ID: "id", Pools: []madmin.RebalancePoolStatus{{ID: 0, Status: tt.poolStatus}}}
+					if tt.poolStatus != "Started" {
+						status.StoppedAt = time.Now()
+					}
+					json.NewEncoder(w).Encode(status)
+				case strings.HasSuffix(r.URL.Path, "/rebalance/stop"):
+					calls = append(calls, "stop")
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			autoCert := false
+			rebalance := tt.rebalance
+			tenant := &miniov2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns"},
+				Spec: miniov2.TenantSpec{
+					RequestAutoCert: &autoCert,
+					Pools:           []miniov2.Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+					Rebalance:       &miniov2.RebalanceConfig{Enabled: !tt.disabled},
+				},
+				Status: miniov2.TenantStatus{Rebalance: &rebalance},
+			}
+			recorder := record.NewFakeRecorder(100)
+			c := &Controller{
+				minioClientSet: fake.NewSimpleClientset(tenant.DeepCopy()),
+				recorder:       recorder,
+				transport: &http.Transport{
+					DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+						return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+					},
+				},
+			}
+			tenantConfiguration := map[string][]byte{"accesskey": []byte("minio"), "secretkey": []byte("minio123")}
+			if _, err := c.checkForRebalance(context.Background(), "ns/tenant", tenant, tenantConfiguration); err != nil {
+				t.Fatalf("checkForRebalance() error = %v", err)
+			}
+			if strings.Join(calls, " ") != strings.Join(tt.wantCalls, " ") {
+				t.Errorf("rebalance calls = %v, want %v", calls, tt.wantCalls)
+			}
+			stored, err := c.minioClientSet.MinioV2().Tenants("ns").Get(context.Background(), "tenant", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := stored.Status.Rebalance
+			if got.State != tt.wantState {
+				t.Errorf("state = %s, want %s", got.State, tt.wantState)
+			}
+			if got.Retries != tt.wantRetries {
+				t.Errorf("retries = %d, want %d", got.Retries, tt.wantRetries)
+			}
+			if (got.NextRetryTime != nil && time.Now().Before(got.NextRetryTime.Time)) != tt.wantRetry {
+				t.Errorf("next retry = %v, want a retry %v", got.NextRetryTime, tt.wantRetry)
+			}
+			if tt.wantRetry && got.Message == "" && tt.rebalance.State != miniov2.RebalanceFailed {
+				t.Errorf("the failure wasn't recorded")
+			}
+			if got.State == miniov2.RebalanceRunning && got.Message != "" {
+				t.Errorf("message = %q, want it cleared", got.Message)
+			}
+			var events []string
+			for len(recorder.Events) > 0 {
+				events = append(events, strings.Fields(<-recorder.Events)[1])
+			}
+			if strings.Join(events, " ") != strings.Join(tt.wantEvents, " ") {
+				t.Errorf("events = %v, want %v", events, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestCheckForRebalanceBacksOff(t *testing.T) {
+	var starts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/rebalance/start") {
+			starts++
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	autoCert := false
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns"},
+		Spec: miniov2.TenantSpec{
+			RequestAutoCert: &autoCert,
+			Pools:           []miniov2.Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 4}},
+			Rebalance:       &miniov2.RebalanceConfig{Enabled: true},
+		},
+		Status: miniov2.TenantStatus{Rebalance: &miniov2.RebalanceStatus{State: miniov2.RebalancePending}},
+	}
+	recorder := record.NewFakeRecorder(100)
+	c := &Controller{
+		minioClientSet: fake.NewSimpleClientset(tenant.DeepCopy()),
+		recorder:       recorder,
+		transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+			},
+		},
+	}
+	tenantConfiguration := map[string][]byte{"accesskey": []byte("minio"), "secretkey": []byte("minio123")}
+	for i := 0; i < 3; i++ {
+		if _, err := c.checkForRebalance(context.Background(), "ns/tenant", tenant, tenantConfiguration); err != nil {
+			t.Fatalf("checkForRebalance() error = %v", err)
+		}
+	}
+	if starts != 1 {
+		t.Errorf("rebalance started %d times, want 1", starts)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("got %d events, want a single RebalanceFailed", len(recorder.Events))
+	}
+	if tenant.Status.Rebalance.State != miniov2.RebalanceFailed || tenant.Status.Rebalance.Message == "" {
+		t.Errorf("rebalance status = %+v, want the failure recorded", tenant.Status.Rebalance)
+	}
+}
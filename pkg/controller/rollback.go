@@ -0,0 +1,136 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/resources/statefulsets"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// checkUpgradeRollback records the image of the tenant as the last known good image once all the pools run it
+// with a green health. If an upgrade doesn't get there before `spec.upgradeRollbackDeadline`, the pools are
+// reverted to the last known good image. Returns true if the tenant was rolled back.
+func (c *Controller) checkUpgradeRollback(ctx context.Context, key string, tenant *miniov2.Tenant, skipEnvVars map[string][]byte) (*miniov2.Tenant, bool, error) {
+	image := tenant.MinIOImage()
+	if tenant.Status.LastKnownGoodImage == image && tenant.Status.UpgradeStartTime == nil {
+		return tenant, false, nil
+	}
+
+	healthy, err := c.poolsHealthyOnImage(tenant, image)
+	if err != nil {
+		return nil, false, err
+	}
+	if healthy {
+		tenant.Status.LastKnownGoodImage = image
+		tenant.Status.UpgradeStartTime = nil
+		if tenant.Status.RolledBackImage != "" && tenant.Status.RolledBackImage != tenant.Spec.Image {
+			tenant.Status.RolledBackImage = ""
+			setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionUpgradeRolledBack, metav1.ConditionFalse, UpgradeCompletedReason, fmt.Sprintf("Tenant upgraded to %s", image)))
+		}
+		if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+			return nil, false, err
+		}
+		return tenant, false, nil
+	}
+
+	deadline := tenant.Spec.UpgradeRollbackDeadline
+	if deadline == nil || deadline.Duration <= 0 || tenant.Status.UpgradeStartTime == nil {
+		return tenant, false, nil
+	}
+	if time.Since(tenant.Status.UpgradeStartTime.Time) < deadline.Duration {
+		return tenant, false, nil
+	}
+	lastKnownGoodImage := tenant.Status.LastKnownGoodImage
+	if lastKnownGoodImage == "" || lastKnownGoodImage == image {
+		return tenant, false, nil
+	}
+
+	msg := fmt.Sprintf("Tenant didn't become healthy running %s within %s, reverting the pools to %s", image, deadline.Duration, lastKnownGoodImage)
+	klog.Warningf("'%s' %s", key, msg)
+	tenant.Status.RolledBackImage = tenant.Spec.Image
+	tenant.Status.UpgradeStartTime = nil
+	tenant.Status.RollingUpgrade = nil
+	setTenantConditions(tenant,
+		newTenantCondition(miniov2.TenantConditionUpgradeRolledBack, metav1.ConditionTrue, UpgradeRolledBackReason, msg),
+		newTenantCondition(miniov2.TenantConditionUpgradeInProgress, metav1.ConditionFalse, UpgradeRolledBackReason, msg),
+		newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionTrue, UpgradeRolledBackReason, msg),
+	)
+	if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+		return nil, false, err
+	}
+	c.recorder.Event(tenant, corev1.EventTypeWarning, "UpgradeRolledBack", msg)
+
+	for i, pool := range tenant.Spec.Pools {
+		ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(tenant.PoolStatefulsetName(&pool))
+		if err != nil {
+			return nil, false, err
+		}
+		if ss.Spec.Template.Spec.Containers[0].Image == lastKnownGoodImage {
+			// the statefulset was never updated, but the binary of its pods may have been updated in place
+			if err = c.kubeClientSet.CoreV1().Pods(tenant.Namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s,%s=%s", miniov2.TenantLabel, tenant.Name, miniov2.PoolLabel, pool.Name),
+			}); err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+		// the status of the pools doesn't follow the spec while pools are decommissioned
+		poolStatus := poolStatusForIndex(tenant, i)
+		if poolStatus == nil {
+			return nil, false, fmt.Errorf("pool %s has no status", pool.Name)
+		}
+		ss = statefulsets.NewPool(&statefulsets.NewPoolArgs{
+			Tenant:          tenant,
+			SkipEnvVars:     skipEnvVars,
+			Pool:            &pool,
+			PoolStatus:      poolStatus,
+			ServiceName:     tenant.MinIOHLServiceName(),
+			HostsTemplate:   c.hostsTemplate,
+			OperatorVersion: c.operatorVersion,
+		})
+		if _, err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Update(ctx, ss, metav1.UpdateOptions{}); err != nil {
+			return nil, false, err
+		}
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolUpdated", fmt.Sprintf("Tenant pool %s updated", pool.Name))
+	}
+	return tenant, true, nil
+}
+
+// poolsHealthyOnImage checks if all the pods of all the pools run the given image, and the tenant health is green
+func (c *Controller) poolsHealthyOnImage(tenant *miniov2.Tenant, image string) (bool, error) {
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen || tenant.Status.RollingUpgrade != nil {
+		return false, nil
+	}
+	for _, pool := range tenant.Spec.Pools {
+		ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(tenant.PoolStatefulsetName(&pool))
+		if err != nil {
+			return false, err
+		}
+		if ss.Spec.Template.Spec.Containers[0].Image != image {
+			return false, nil
+		}
+		if ss.Status.ObservedGeneration < ss.Generation || ss.Status.UpdatedReplicas < pool.Servers || ss.Status.ReadyReplicas < pool.Servers {
+			return false, nil
+		}
+	}
+	return tenant.MinIOHealthCheck(c.getTransport()), nil
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/resources/statefulsets"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// startRollingUpgrade records the upgrade of the tenant to the image in the spec. The pods are then restarted
+// one at a time by rollingUpgrade.
+func (c *Controller) startRollingUpgrade(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	var totalPods int32
+	for _, pool := range tenant.Spec.Pools {
+		totalPods += pool.Servers
+	}
+	now := metav1.Now()
+	tenant.Status.RollingUpgrade = &miniov2.RollingUpgradeStatus{
+		Image:     tenant.MinIOImage(),
+		Pool:      tenant.Spec.Pools[0].Name,
+		Partition: tenant.Spec.Pools[0].Servers,
+		TotalPods: totalPods,
+		StartTime: &now,
+	}
+	tenant, err := c.updatePoolStatus(ctx, tenant)
+	if err != nil {
+		klog.Infof("'%s' Can't update tenant status: %v", key, err)
+		return nil, err
+	}
+	klog.Infof("'%s' Starting rolling upgrade to %s", key, tenant.MinIOImage())
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "RollingUpgradeStarted", fmt.Sprintf("Rolling upgrade to %s started", tenant.MinIOImage()))
+	return tenant, nil
+}
+
+// rollingUpgrade restarts the pods of the pool being upgraded one at a time, and moves to the next pool once
+// all of its pods run the new image. A pod is only restarted when the tenant is healthy and taking the pod
+// down keeps write quorum. Returns true while the upgrade is in progress.
+func (c *Controller) rollingUpgrade(ctx context.Context, key string, tenant *miniov2.Tenant, skipEnvVars map[string][]byte) (*miniov2.Tenant, bool, error) {
+	upgrade := tenant.Status.RollingUpgrade
+	if upgrade == nil {
+		return tenant, false, nil
+	}
+
+	// the image changed again during the upgrade, start over from the first pool
+	if upgrade.Image != tenant.MinIOImage() {
+		var err error
+		if tenant, err = c.startRollingUpgrade(ctx, key, tenant); err != nil {
+			return nil, false, err
+		}
+		upgrade = tenant.Status.RollingUpgrade
+	}
+
+	poolIndex := -1
+	var updatedPods int32
+	for i, pool := range tenant.Spec.Pools {
+		if pool.Name == upgrade.Pool {
+			poolIndex = i
+			break
+		}
+		updatedPods += pool.Servers
+	}
+	if poolIndex < 0 {
+		return nil, false, fmt.Errorf("pool %s being upgraded is no longer part of the tenant", upgrade.Pool)
+	}
+
+	// every pool runs the new image, the partitions keep the pods from restarting until their turn
+	for i, pool := range tenant.Spec.Pools {
+		ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(tenant.PoolStatefulsetName(&pool))
+		if err != nil {
+			return nil, false, err
+		}
+		if ss.Spec.Template.Spec.Containers[0].Image == tenant.MinIOImage() {
+			continue
+		}
+		// the status of the pools doesn't follow the spec while pools are decommissioned
+		poolStatus := poolStatusForIndex(tenant, i)
+		if poolStatus == nil {
+			return nil, false, fmt.Errorf("pool %s has no status", pool.Name)
+		}
+		ss = statefulsets.NewPool(&statefulsets.NewPoolArgs{
+			Tenant:          tenant,
+			SkipEnvVars:     skipEnvVars,
+			Pool:            &pool,
+			PoolStatus:      poolStatus,
+			ServiceName:     tenant.MinIOHLServiceName(),
+			HostsTemplate:   c.hostsTemplate,
+			OperatorVersion: c.operatorVersion,
+		})
+		if _, err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Update(ctx, ss, metav1.UpdateOptions{}); err != nil {
+			return nil, false, err
+		}
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "PoolUpdated", fmt.Sprintf("Tenant pool %s updated", pool.Name))
+	}
+
+	pool := tenant.Spec.Pools[poolIndex]
+	ssName := tenant.PoolStatefulsetName(&pool)
+	ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(ssName)
+	if err != nil {
+		return nil, false, err
+	}
+	// wait for the pods restarted so far to be ready
+	if ss.Status.ObservedGeneration < ss.Generation || ss.Status.ReadyReplicas < pool.Servers || ss.Status.UpdatedReplicas < pool.Servers-upgrade.Partition {
+		klog.Infof("'%s' Waiting for pool %s to be ready before continuing the rolling upgrade", key, pool.Name)
+		return tenant, true, nil
+	}
+	if !tenant.MinIOHealthCheck(c.getTransport()) {
+		klog.Infof("'%s' Waiting for MinIO to be healthy before continuing the rolling upgrade", key)
+		return tenant, true, nil
+	}
+
+	if upgrade.Partition == 0 {
+		if poolIndex == len(tenant.Spec.Pools)-1 {
+			image := upgrade.Image
+			tenant.Status.RollingUpgrade = nil
+			setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionUpgradeInProgress, metav1.ConditionFalse, UpgradeCompletedReason, fmt.Sprintf("Tenant upgraded to %s", image)))
+			if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+				return nil, false, err
+			}
+			klog.Infof("'%s' Rolling upgrade to %s completed", key, image)
+			c.recorder.Event(tenant, corev1.EventTypeNormal, "RollingUpgradeCompleted", fmt.Sprintf("Rolling upgrade to %s completed", image))
+			return tenant, false, nil
+		}
+		next := tenant.Spec.Pools[poolIndex+1]
+		upgrade.Pool = next.Name
+		upgrade.Partition = next.Servers
+		upgrade.UpdatedPods = updatedPods + pool.Servers
+		if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+			return nil, false, err
+		}
+		return tenant, true, nil
+	}
+
+	podName := fmt.Sprintf("%s-%d", ssName, upgrade.Partition-1)
+	if !tenant.MinIOMaintenanceCheck(podName, c.getTransport()) {
+		klog.Infof("'%s' Waiting to restart pod %s without losing write quorum", key, podName)
+		return tenant, true, nil
+	}
+
+	// record the progress first, so the partition of the statefulsets generated by the operator agrees
+	partition := upgrade.Partition - 1
+	upgrade.Partition = partition
+	upgrade.UpdatedPods = updatedPods + pool.Servers - partition
+	if tenant, err = c.updatePoolStatus(ctx, tenant); err != nil {
+		return nil, false, err
+	}
+	ss = ss.DeepCopy()
+	ss.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+			Partition: &partition,
+		},
+	}
+	if _, err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Update(ctx, ss, metav1.UpdateOptions{}); err != nil {
+		return nil, false, err
+	}
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "PodUpgraded", fmt.Sprintf("Restarting pod %s with %s", podName, tenant.MinIOImage()))
+	return tenant, true, nil
+}
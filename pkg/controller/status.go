@@ -20,28 +20,36 @@ import (
 	"context"
 
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
 
 func (c *Controller) updateTenantStatus(ctx context.Context, tenant *miniov2.Tenant, currentState string, availableReplicas int32) (*miniov2.Tenant, error) {
-	return c.updateTenantStatusWithRetry(ctx, tenant, currentState, availableReplicas, true)
+	return c.updateTenantStatusWithConditions(ctx, tenant, currentState, availableReplicas, tenantConditionsForState(currentState)...)
 }
 
-func (c *Controller) updateTenantStatusWithRetry(ctx context.Context, tenant *miniov2.Tenant, currentState string, availableReplicas int32, retry bool) (*miniov2.Tenant, error) {
-	// If we are updating the tenant with the same status as before we are going to skip it as to avoid a resource number
-	// change and have the operator loop re-processing the tenant endlessly
-	if tenant.Status.CurrentState == currentState && tenant.Status.AvailableReplicas == availableReplicas {
-		return tenant, nil
-	}
+func (c *Controller) updateTenantStatusWithConditions(ctx context.Context, tenant *miniov2.Tenant, currentState string, availableReplicas int32, conditions ...metav1.Condition) (*miniov2.Tenant, error) {
+	return c.updateTenantStatusWithRetry(ctx, tenant, currentState, availableReplicas, conditions, true)
+}
+
+func (c *Controller) updateTenantStatusWithRetry(ctx context.Context, tenant *miniov2.Tenant, currentState string, availableReplicas int32, conditions []metav1.Condition, retry bool) (*miniov2.Tenant, error) {
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
 	tenantCopy := tenant.DeepCopy()
-	tenantCopy.Spec = miniov2.TenantSpec{}
 	tenantCopy.Status.AvailableReplicas = availableReplicas
 	tenantCopy.Status.CurrentState = currentState
+	setTenantConditions(tenantCopy, conditions...)
+	setTenantHealthConditions(tenantCopy, currentState == StatusInitialized)
+	// If we are updating the tenant with the same status as before we are going to skip it as to avoid a resource number
+	// change and have the operator loop re-processing the tenant endlessly
+	if equality.Semantic.DeepEqual(tenant.Status, tenantCopy.Status) {
+		return tenant, nil
+	}
+	tenantCopy.Spec = miniov2.TenantSpec{}
 	// If the CustomResourceSubresources feature gate is not enabled,
 	// we must use Update instead of UpdateStatus to update the Status block of the Tenant resource.
 	// UpdateStatus will not allow changes to the Spec of the resource,
@@ -57,13 +65,161 @@ func (c *Controller) updateTenantStatusWithRetry(ctx context.Context, tenant *mi
 			if err != nil {
 				return tenant, err
 			}
-			return c.updateTenantStatusWithRetry(ctx, tenant, currentState, availableReplicas, false)
+			return c.updateTenantStatusWithRetry(ctx, tenant, currentState, availableReplicas, conditions, false)
+		}
+		return t, err
+	}
+	return t, nil
+}
+
+func (c *Controller) updateTenantConditions(ctx context.Context, tenant *miniov2.Tenant, conditions ...metav1.Condition) (*miniov2.Tenant, error) {
+	return c.updateTenantConditionsWithRetry(ctx, tenant, conditions, true)
+}
+
+func (c *Controller) updateTenantConditionsWithRetry(ctx context.Context, tenant *miniov2.Tenant, conditions []metav1.Condition, retry bool) (*miniov2.Tenant, error) {
+	tenantCopy := tenant.DeepCopy()
+	setTenantConditions(tenantCopy, conditions...)
+	// skip the update if none of the conditions changed
+	if equality.Semantic.DeepEqual(tenant.Status.Conditions, tenantCopy.Status.Conditions) {
+		return tenant, nil
+	}
+	tenantCopy.Spec = miniov2.TenantSpec{}
+	opts := metav1.UpdateOptions{}
+	t, err := c.minioClientSet.MinioV2().Tenants(tenant.Namespace).UpdateStatus(ctx, tenantCopy, opts)
+	t.EnsureDefaults()
+	if err != nil {
+		if k8serrors.IsConflict(err) && retry {
+			klog.Info("Hit conflict issue, getting latest version of tenant")
+			tenant, err = c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Get(ctx, tenant.Name, metav1.GetOptions{})
+			if err != nil {
+				return tenant, err
+			}
+			return c.updateTenantConditionsWithRetry(ctx, tenant, conditions, false)
 		}
 		return t, err
 	}
 	return t, nil
 }
 
+// setTenantConditions sets the conditions on the tenant status, the transition time only changes when the status does
+func setTenantConditions(tenant *miniov2.Tenant, conditions ...metav1.Condition) {
+	for _, condition := range conditions {
+		condition.ObservedGeneration = tenant.Generation
+		meta.SetStatusCondition(&tenant.Status.Conditions, condition)
+	}
+}
+
+// newTenantCondition returns a condition of the given type for the tenant
+func newTenantCondition(conditionType string, status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+// tenantConditionsForState translates a tenant state into the conditions it represents
+func tenantConditionsForState(currentState string) []metav1.Condition {
+	reason, ok := tenantStateReasons[currentState]
+	if !ok {
+		// any other state is an error message from the reconcile loop
+		return []metav1.Condition{
+			newTenantCondition(miniov2.TenantConditionProgressing, metav1.ConditionFalse, ReconcileErrorReason, currentState),
+			newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionTrue, ReconcileErrorReason, currentState),
+		}
+	}
+	progressing := newTenantCondition(miniov2.TenantConditionProgressing, metav1.ConditionTrue, reason, currentState)
+	switch currentState {
+	case StatusInitialized:
+		return []metav1.Condition{
+			newTenantCondition(miniov2.TenantConditionProgressing, metav1.ConditionFalse, reason, "Tenant is initialized"),
+			newTenantCondition(miniov2.TenantConditionCertificatesReady, metav1.ConditionTrue, reason, "All certificates are issued"),
+			newTenantCondition(miniov2.TenantConditionPoolsInitialized, metav1.ConditionTrue, reason, "All pools are initialized"),
+			newTenantCondition(miniov2.TenantConditionUpgradeInProgress, metav1.ConditionFalse, reason, "Tenant is running the requested version"),
+		}
+	case StatusWaitingMinIOCert, StatusWaitingMinIOClientCert, StatusWaitingKESCert:
+		return []metav1.Condition{
+			progressing,
+			newTenantCondition(miniov2.TenantConditionCertificatesReady, metav1.ConditionFalse, reason, currentState),
+		}
+	case StatusProvisioningStatefulSet:
+		return []metav1.Condition{
+			progressing,
+			newTenantCondition(miniov2.TenantConditionPoolsInitialized, metav1.ConditionFalse, reason, currentState),
+		}
+	case StatusProvisioningKESStatefulSet, StatusUpdatingKES:
+		return []metav1.Condition{
+			progressing,
+			newTenantCondition(miniov2.TenantConditionKESReady, metav1.ConditionFalse, reason, currentState),
+		}
+	case StatusUpdatingMinIOVersion:
+		return []metav1.Condition{
+			progressing,
+			newTenantCondition(miniov2.TenantConditionUpgradeInProgress, metav1.ConditionTrue, reason, currentState),
+		}
+	case StatusSuspended:
+		return []metav1.Condition{
+			newTenantCondition(miniov2.TenantConditionProgressing, metav1.ConditionFalse, reason, "Tenant is suspended"),
+		}
+	case StatusNotOwned, StatusTenantCredentialsNotSet, StatusInconsistentMinIOVersions, StatusDecommissioningNotAllowed:
+		return []metav1.Condition{
+			newTenantCondition(miniov2.TenantConditionProgressing, metav1.ConditionFalse, reason, currentState),
+			newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionTrue, reason, currentState),
+		}
+	}
+	return []metav1.Condition{progressing}
+}
+
+// degradedTenantConditions returns the conditions of a tenant the operator can't reconcile
+func degradedTenantConditions(reason, message string) []metav1.Condition {
+	return []metav1.Condition{
+		newTenantCondition(miniov2.TenantConditionProgressing, metav1.ConditionFalse, reason, message),
+		newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionTrue, reason, message),
+	}
+}
+
+// upgradeFailedTenantConditions returns the conditions of a tenant whose MinIO version update failed
+func upgradeFailedTenantConditions(message string) []metav1.Condition {
+	return append(degradedTenantConditions(UpgradeFailedReason, message),
+		newTenantCondition(miniov2.TenantConditionUpgradeInProgress, metav1.ConditionFalse, UpgradeFailedReason, message))
+}
+
+// setTenantHealthConditions derives the Available and Degraded conditions from the health of the tenant, they have no
+// other writer so the reconcile loop and the health checks can't flap them. A reconcile error keeps the tenant degraded
+// until a reconcile succeeds.
+func setTenantHealthConditions(tenant *miniov2.Tenant, reconciled bool) {
+	degraded := meta.FindStatusCondition(tenant.Status.Conditions, miniov2.TenantConditionDegraded)
+	reconcileError := !reconciled && degraded != nil && degraded.Status == metav1.ConditionTrue &&
+		degraded.Reason != HealthYellowReason && degraded.Reason != HealthRedReason
+	var available, degradedCondition metav1.Condition
+	switch tenant.Status.HealthStatus {
+	case miniov2.HealthStatusGreen:
+		available = newTenantCondition(miniov2.TenantConditionAvailable, metav1.ConditionTrue, HealthGreenReason, "Tenant is healthy")
+		degradedCondition = newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionFalse, HealthGreenReason, "Tenant is healthy")
+	case miniov2.HealthStatusYellow:
+		available = newTenantCondition(miniov2.TenantConditionAvailable, metav1.ConditionTrue, HealthYellowReason, tenant.Status.HealthMessage)
+		degradedCondition = newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionTrue, HealthYellowReason, tenant.Status.HealthMessage)
+	case miniov2.HealthStatusSuspended:
+		// the pods were stopped on purpose, the tenant isn't degraded
+		available = newTenantCondition(miniov2.TenantConditionAvailable, metav1.ConditionFalse, SuspendedReason, "Tenant is suspended")
+		degradedCondition = newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionFalse, SuspendedReason, "Tenant is suspended")
+	case miniov2.HealthStatusRed:
+		available = newTenantCondition(miniov2.TenantConditionAvailable, metav1.ConditionFalse, HealthRedReason, tenant.Status.HealthMessage)
+		degradedCondition = newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionTrue, HealthRedReason, tenant.Status.HealthMessage)
+	default:
+		// the health of the tenant isn't known yet, only clear a reconcile error that no longer applies
+		if reconciled && degraded != nil && degraded.Status == metav1.ConditionTrue {
+			setTenantConditions(tenant, newTenantCondition(miniov2.TenantConditionDegraded, metav1.ConditionFalse, InitializedReason, "Tenant is initialized"))
+		}
+		return
+	}
+	setTenantConditions(tenant, available)
+	if !reconcileError {
+		setTenantConditions(tenant, degradedCondition)
+	}
+}
+
 func (c *Controller) updatePoolStatus(ctx context.Context, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
 	return c.updatePoolStatusWithRetry(ctx, tenant, true)
 }
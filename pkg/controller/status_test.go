@@ -0,0 +1,140 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"testing"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_tenantConditionsForState(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         string
+		conditionType string
+		wantStatus    metav1.ConditionStatus
+		wantReason    string
+	}{
+		{
+			name:          "Initialized tenant is not progressing",
+			state:         StatusInitialized,
+			conditionType: miniov2.TenantConditionProgressing,
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    InitializedReason,
+		},
+		{
+			name:          "Waiting for certificates",
+			state:         StatusWaitingMinIOCert,
+			conditionType: miniov2.TenantConditionCertificatesReady,
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    "WaitingMinIOCert",
+		},
+		{
+			name:          "Updating MinIO version",
+			state:         StatusUpdatingMinIOVersion,
+			conditionType: miniov2.TenantConditionUpgradeInProgress,
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    "UpdatingMinIOVersion",
+		},
+		{
+			name:          "Tenant not owned",
+			state:         StatusNotOwned,
+			conditionType: miniov2.TenantConditionDegraded,
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    "NotOwned",
+		},
+		{
+			name:          "Suspended tenant is not progressing",
+			state:         StatusSuspended,
+			conditionType: miniov2.TenantConditionProgressing,
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    SuspendedReason,
+		},
+		{
+			name:          "Unknown error",
+			state:         "something went wrong",
+			conditionType: miniov2.TenantConditionDegraded,
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    ReconcileErrorReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := &miniov2.Tenant{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+			setTenantConditions(tenant, tenantConditionsForState(tt.state)...)
+			condition := meta.FindStatusCondition(tenant.Status.Conditions, tt.conditionType)
+			if condition == nil {
+				t.Fatalf("condition %s not set", tt.conditionType)
+			}
+			if condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("condition %s = %s/%s, want %s/%s", tt.conditionType, condition.Status, condition.Reason, tt.wantStatus, tt.wantReason)
+			}
+			if condition.ObservedGeneration != 3 {
+				t.Errorf("condition %s observedGeneration = %d, want 3", tt.conditionType, condition.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func Test_setTenantHealthConditions(t *testing.T) {
+	tenant := &miniov2.Tenant{}
+
+	// a reconcile error is not cleared by the health of the tenant
+	setTenantConditions(tenant, degradedTenantConditions(InvalidSpecReason, "invalid")...)
+	tenant.Status.HealthStatus = miniov2.HealthStatusGreen
+	setTenantHealthConditions(tenant, false)
+	if !meta.IsStatusConditionTrue(tenant.Status.Conditions, miniov2.TenantConditionDegraded) {
+		t.Errorf("green health must not clear a reconcile error")
+	}
+
+	// a successful reconcile clears it
+	setTenantHealthConditions(tenant, true)
+	if !meta.IsStatusConditionFalse(tenant.Status.Conditions, miniov2.TenantConditionDegraded) {
+		t.Errorf("a successful reconcile must clear the reconcile error")
+	}
+
+	// drives offline degrade the tenant until it's green again, whatever the state of the reconcile
+	tenant.Status.HealthStatus = miniov2.HealthStatusYellow
+	setTenantHealthConditions(tenant, false)
+	if !meta.IsStatusConditionTrue(tenant.Status.Conditions, miniov2.TenantConditionDegraded) {
+		t.Errorf("yellow health must degrade the tenant")
+	}
+	setTenantHealthConditions(tenant, true)
+	if !meta.IsStatusConditionTrue(tenant.Status.Conditions, miniov2.TenantConditionDegraded) {
+		t.Errorf("a successful reconcile must not clear the degraded health")
+	}
+	tenant.Status.HealthStatus = miniov2.HealthStatusGreen
+	setTenantHealthConditions(tenant, false)
+	if !meta.IsStatusConditionFalse(tenant.Status.Conditions, miniov2.TenantConditionDegraded) {
+		t.Errorf("green health must clear the degraded condition")
+	}
+
+	tenant.Status.HealthStatus = miniov2.HealthStatusRed
+	setTenantHealthConditions(tenant, false)
+	if !meta.IsStatusConditionFalse(tenant.Status.Conditions, miniov2.TenantConditionAvailable) {
+		t.Errorf("red health must set the tenant as not available")
+	}
+
+	tenant.Status.HealthStatus = miniov2.HealthStatusSuspended
+	setTenantHealthConditions(tenant, false)
+	if !meta.IsStatusConditionFalse(tenant.Status.Conditions, miniov2.TenantConditionDegraded) {
+		t.Errorf("suspended health must clear the degraded condition")
+	}
+}
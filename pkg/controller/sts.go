@@ -85,6 +85,7 @@ type ReqInfo struct {
 	API             string // API name
 	AccessKey       string // Access Key
 	TenantNamespace string // tenant namespace
+	TenantName      string // tenant name
 	sync.RWMutex
 }
 
@@ -265,6 +266,10 @@ func configureSTSServer(c *Controller) *http.Server {
 		Path(STSEndpoint + "/{tenantNamespace}").
 		HandlerFunc(c.AssumeRoleWithWebIdentityHandler)
 
+	router.Methods(http.MethodPost).
+		Path(STSEndpoint + "/{tenantNamespace}/{tenantName}").
+		HandlerFunc(c.AssumeRoleWithWebIdentityHandler)
+
 	router.NotFoundHandler = http.NotFoundHandler()
 
 	s := &http.Server{
@@ -49,12 +49,13 @@ const (
 
 const contextLogKey = contextKeyType("operatorlog")
 
-// AssumeRoleWithWebIdentityHandler - POST /sts/{tenantNamespace}
+// AssumeRoleWithWebIdentityHandler - POST /sts/{tenantNamespace} or POST /sts/{tenantNamespace}/{tenantName}
 // AssumeRoleWithWebIdentity - implementation of AWS STS API.
+// The tenant name can only be omitted when the namespace holds a single tenant.
 // Authenticates a Kubernetes Service accounts using a JWT Token
 // Evalues a PolicyBinding CRD as Mapping of the Minio Policies that the ServiceAccount can assume on a minio tenant
 // Eg:-
-// $ curl -k -X POST https://operator:9443/sts/{tenantNamespace} -d "Version=2011-06-15&Action=AssumeRoleWithWebIdentity&WebIdentityToken=<jwt>" -H "Content-Type: application/x-www-form-urlencoded"
+// $ curl -k -X POST https://operator:9443/sts/{tenantNamespace}/{tenantName} -d "Version=2011-06-15&Action=AssumeRoleWithWebIdentity&WebIdentityToken=<jwt>" -H "Content-Type: application/x-www-form-urlencoded"
 func (c *Controller) AssumeRoleWithWebIdentityHandler(w http.ResponseWriter, r *http.Request) {
 	routerVars := mux.Vars(r)
 	tenantNamespace := ""
@@ -63,6 +64,11 @@ func (c *Controller) AssumeRoleWithWebIdentityHandler(w http.ResponseWriter, r *
 		writeSTSErrorResponse(w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Unable to unescape tenant namespace: %s", err))
 		return
 	}
+	tenantName, err := xhttp.UnescapeQueryPath(routerVars["tenantName"])
+	if err != nil {
+		writeSTSErrorResponse(w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Unable to unescape tenant name: %s", err))
+		return
+	}
 
 	reqInfo := ReqInfo{
 		RequestID:       w.Header().Get(AmzRequestID),
@@ -71,6 +77,7 @@ func (c *Controller) AssumeRoleWithWebIdentityHandler(w http.ResponseWriter, r *
 		UserAgent:       r.UserAgent(),
 		API:             webIdentity,
 		TenantNamespace: tenantNamespace,
+		TenantName:      tenantName,
 	}
 
 	ctx := context.WithValue(r.Context(), contextLogKey, &reqInfo)
@@ -149,21 +156,13 @@ func (c *Controller) AssumeRoleWithWebIdentityHandler(w http.ResponseWriter, r *
 		return
 	}
 
-	tenants, err := c.minioClientSet.MinioV2().Tenants(tenantNamespace).List(ctx, metav1.ListOptions{})
-
-	if err != nil || len(tenants.Items) == 0 {
-		if k8serrors.IsNotFound(err) {
-			writeSTSErrorResponse(w, true, ErrSTSInvalidParameterValue, fmt.Errorf("No tenant found in namespace '%s'", tenantNamespace))
-			return
-		}
-		writeSTSErrorResponse(w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Error getting tenant in namespace '%s'", tenantNamespace))
+	tenant, err := c.getSTSTenant(ctx, tenantNamespace, tenantName)
+	if err != nil {
+		writeSTSErrorResponse(w, true, ErrSTSInvalidParameterValue, err)
 		return
 	}
 
-	// Only one tenant is allowed in a single namespace, gathering the first tenant in the list
-	tenant := tenants.Items[0]
-
-	tenantConfiguration, err := c.getTenantCredentials(ctx, &tenant)
+	tenantConfiguration, err := c.getTenantCredentials(ctx, tenant)
 	if err != nil {
 		if errors.Is(err, ErrEmptyRootCredentials) {
 			writeSTSErrorResponse(w, true, ErrSTSInternalError, fmt.Errorf("Tenant '%s' is missing root credentials", tenant.Name))
@@ -259,7 +258,7 @@ func (c *Controller) AssumeRoleWithWebIdentityHandler(w http.ResponseWriter, r *
 		durationInSeconds = duration
 	}
 
-	stsCredentials, err := AssumeRole(ctx, c, &tenant, region, bfCompact, durationInSeconds)
+	stsCredentials, err := AssumeRole(ctx, c, tenant, region, bfCompact, durationInSeconds)
 	if err != nil {
 		writeSTSErrorResponse(w, true, ErrSTSInternalError, err)
 		return
@@ -279,3 +278,31 @@ func (c *Controller) AssumeRoleWithWebIdentityHandler(w http.ResponseWriter, r *
 	assumeRoleResponse.ResponseMetadata.RequestID = w.Header().Get(AmzRequestID)
 	writeSuccessResponseXML(w, xhttp.EncodeResponse(assumeRoleResponse))
 }
+
+// getSTSTenant returns the tenant the credentials are requested for. The tenant name can be omitted when the
+// namespace holds a single tenant.
+func (c *Controller) getSTSTenant(ctx context.Context, tenantNamespace, tenantName string) (*miniov2.Tenant, error) {
+	if tenantName != "" {
+		tenant, err := c.minioClientSet.MinioV2().Tenants(tenantNamespace).Get(ctx, tenantName, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return nil, fmt.Errorf("Tenant '%s' not found in namespace '%s'", tenantName, tenantNamespace)
+			}
+			return nil, fmt.Errorf("Error getting tenant '%s' in namespace '%s'", tenantName, tenantNamespace)
+		}
+		return tenant, nil
+	}
+
+	tenants, err := c.minioClientSet.MinioV2().Tenants(tenantNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting tenant in namespace '%s'", tenantNamespace)
+	}
+	switch len(tenants.Items) {
+	case 0:
+		return nil, fmt.Errorf("No tenant found in namespace '%s'", tenantNamespace)
+	case 1:
+		return &tenants.Items[0], nil
+	default:
+		return nil, fmt.Errorf("Multiple tenants found in namespace '%s', use %s/%s/{tenantName}", tenantNamespace, STSEndpoint, tenantNamespace)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// suspendTenant scales the statefulsets of the pools and KES down to zero and removes the PodDisruptionBudgets of the
+// tenant. The PVCs, secrets and certificates are kept. The replicas of each statefulset are kept on an annotation, so
+// they are restored when the tenant is resumed.
+func (c *Controller) suspendTenant(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	for _, name := range tenantStatefulSetNames(tenant) {
+		if err := c.suspendStatefulSet(ctx, tenant, name); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.DeletePDB(ctx, tenant); err != nil {
+		return nil, err
+	}
+	if tenant.Status.CurrentState != StatusSuspended {
+		klog.Infof("'%s' Tenant suspended", key)
+		c.recorder.Event(tenant, corev1.EventTypeNormal, "TenantSuspended", "Tenant scaled down to zero pods, its PVCs are kept")
+	}
+	tenant.Status.HealthStatus = miniov2.HealthStatusSuspended
+	tenant.Status.HealthMessage = HealthSuspendedMessage
+	return c.updateTenantStatus(ctx, tenant, StatusSuspended, 0)
+}
+
+// resumeTenant restores the replicas the statefulsets of a suspended tenant had. It's decided from the annotation left
+// on the statefulsets, so a suspend that didn't finish is undone too. The tenant waits for MinIO to be healthy again
+// before it's reconciled any further, so it's checked by the health monitor until it's green.
+func (c *Controller) resumeTenant(ctx context.Context, key string, tenant *miniov2.Tenant) (*miniov2.Tenant, bool, error) {
+	var resumed bool
+	for _, name := range tenantStatefulSetNames(tenant) {
+		scaled, err := c.resumeStatefulSet(ctx, tenant, name)
+		if err != nil {
+			return nil, false, err
+		}
+		resumed = resumed || scaled
+	}
+	if !resumed && tenant.Status.CurrentState != StatusSuspended {
+		return tenant, false, nil
+	}
+	klog.Infof("'%s' Tenant resumed", key)
+	c.recorder.Event(tenant, corev1.EventTypeNormal, "TenantResumed", "Tenant scaled up, waiting for MinIO to be healthy")
+	c.healthCheckQueue.Add(key)
+	tenant.Status.HealthStatus = ""
+	tenant.Status.HealthMessage = ""
+	tenant, err := c.updateTenantStatus(ctx, tenant, StatusWaitingMinIOIsHealthy, 0)
+	return tenant, true, err
+}
+
+// tenantStatefulSetNames returns the statefulsets of the pools of the tenant, including the pools being
+// decommissioned, and the KES statefulset
+func tenantStatefulSetNames(tenant *miniov2.Tenant) []string {
+	var names []string
+	for _, pool := range tenant.Status.Pools {
+		names = append(names, pool.SSName)
+	}
+	if tenant.HasKESEnabled() {
+		names = append(names, tenant.KESStatefulSetName())
+	}
+	return names
+}
+
+// suspendStatefulSet scales a statefulset of the tenant down to zero, keeping its replicas on an annotation
+func (c *Controller) suspendStatefulSet(ctx context.Context, tenant *miniov2.Tenant, name string) error {
+	ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if ss.Spec.Replicas == nil || *ss.Spec.Replicas == 0 || !metav1.IsControlledBy(ss, tenant) {
+		return nil
+	}
+	ss = ss.DeepCopy()
+	if ss.Annotations == nil {
+		ss.Annotations = map[string]string{}
+	}
+	ss.Annotations[miniov2.SuspendedReplicasAnnotation] = strconv.Itoa(int(*ss.Spec.Replicas))
+	var replicas int32
+	ss.Spec.Replicas = &replicas
+	_, err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Update(ctx, ss, metav1.UpdateOptions{})
+	return err
+}
+
+// resumeStatefulSet restores the replicas a statefulset of the tenant had before it was suspended, it returns whether
+// the statefulset was scaled up
+func (c *Controller) resumeStatefulSet(ctx context.Context, tenant *miniov2.Tenant, name string) (bool, error) {
+	ss, err := c.statefulSetLister.StatefulSets(tenant.Namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	value, ok := ss.Annotations[miniov2.SuspendedReplicasAnnotation]
+	if !ok || !metav1.IsControlledBy(ss, tenant) {
+		return false, nil
+	}
+	replicas, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return false, err
+	}
+	ss = ss.DeepCopy()
+	delete(ss.Annotations, miniov2.SuspendedReplicasAnnotation)
+	restored := int32(replicas)
+	ss.Spec.Replicas = &restored
+	if _, err = c.kubeClientSet.AppsV1().StatefulSets(tenant.Namespace).Update(ctx, ss, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
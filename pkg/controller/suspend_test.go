@@ -0,0 +1,208 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	miniofake "github.com/minio/operator/pkg/client/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	queue "k8s.io/client-go/util/workqueue"
+)
+
+func suspendTestTenant(state string) *miniov2.Tenant {
+	tenant := &miniov2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "ns", UID: "tenant-uid"},
+		Spec:       miniov2.TenantSpec{Pools: []miniov2.Pool{{Name: "pool-0", Servers: 4, VolumesPerServer: 1}}},
+	}
+	tenant.Status.CurrentState = state
+	tenant.Status.Pools = []miniov2.PoolStatus{{SSName: "tenant-pool-0", State: miniov2.PoolInitialized}}
+	return tenant
+}
+
+// suspendTestStatefulSet returns the statefulset of pool-0, suspended ones keep their replicas on the annotation
+func suspendTestStatefulSet(tenant *miniov2.Tenant, replicas int32, suspended string) *appsv1.StatefulSet {
+	ss := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "tenant-pool-0",
+			Namespace:       "ns",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(tenant, miniov2.SchemeGroupVersion.WithKind(miniov2.MinIOCRDResourceKind))},
+		},
+		Spec: appsv1.StatefulSetSpec{Replicas: &replicas},
+	}
+	if suspended != "" {
+		ss.Annotations = map[string]string{miniov2.SuspendedReplicasAnnotation: suspended}
+	}
+	return ss
+}
+
+func newSuspendTestController(tenant *miniov2.Tenant, objects ...runtime.Object) *Controller {
+	return &Controller{
+		kubeClientSet:    fake.NewSimpleClientset(objects...),
+		minioClientSet:   miniofake.NewSimpleClientset(tenant.DeepCopy()),
+		recorder:         record.NewFakeRecorder(100),
+		healthCheckQueue: queue.NewRateLimitingQueue(queue.DefaultControllerRateLimiter()),
+	}
+}
+
+// syncStatefulSetCache refreshes the statefulset lister of the controller with what the API holds
+func syncStatefulSetCache(t *testing.T, c *Controller) {
+	t.Helper()
+	list, err := c.kubeClientSet.AppsV1().StatefulSets("ns").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for i := range list.Items {
+		indexer.Add(&list.Items[i])
+	}
+	c.statefulSetLister = appslisters.NewStatefulSetLister(indexer)
+}
+
+func checkStatefulSet(t *testing.T, c *Controller, wantReplicas int32, wantSuspended string) {
+	t.Helper()
+	ss, err := c.kubeClientSet.AppsV1().StatefulSets("ns").Get(context.Background(), "tenant-pool-0", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *ss.Spec.Replicas != wantReplicas {
+		t.Errorf("statefulset replicas = %d, want %d", *ss.Spec.Replicas, wantReplicas)
+	}
+	if got := ss.Annotations[miniov2.SuspendedReplicasAnnotation]; got != wantSuspended {
+		t.Errorf("suspended replicas annotation = %q, want %q", got, wantSuspended)
+	}
+}
+
+func TestSuspendTenant(t *testing.T) {
+	tenant := suspendTestTenant(StatusInitialized)
+	c := newSuspendTestController(tenant, suspendTestStatefulSet(tenant, 4, ""))
+	syncStatefulSetCache(t, c)
+
+	tenant, err := c.suspendTenant(context.Background(), "ns/tenant", tenant)
+	if err != nil {
+		t.Fatalf("suspendTenant() error = %v", err)
+	}
+	checkStatefulSet(t, c, 0, "4")
+	if tenant.Status.CurrentState != StatusSuspended || tenant.Status.HealthStatus != miniov2.HealthStatusSuspended {
+		t.Errorf("tenant state = %s, health %s, want suspended", tenant.Status.CurrentState, tenant.Status.HealthStatus)
+	}
+	if events := len(c.recorder.(*record.FakeRecorder).Events); events != 1 {
+		t.Errorf("suspendTenant() emitted %d events, want 1", events)
+	}
+
+	// suspending again keeps the replicas the pool had before the first suspend
+	syncStatefulSetCache(t, c)
+	if _, err = c.suspendTenant(context.Background(), "ns/tenant", tenant); err != nil {
+		t.Fatalf("suspendTenant() error = %v", err)
+	}
+	checkStatefulSet(t, c, 0, "4")
+	if events := len(c.recorder.(*record.FakeRecorder).Events); events != 1 {
+		t.Errorf("suspending a suspended tenant emitted %d events, want 1", events)
+	}
+}
+
+func TestResumeTenant(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        string
+		replicas     int32
+		suspended    string
+		wantResumed  bool
+		wantReplicas int32
+	}{
+		{
+			name:         "Suspended tenant",
+			state:        StatusSuspended,
+			suspended:    "4",
+			wantResumed:  true,
+			wantReplicas: 4,
+		},
+		{
+			name:         "Suspend that didn't update the status",
+			state:        StatusInitialized,
+			suspended:    "4",
+			wantResumed:  true,
+			wantReplicas: 4,
+		},
+		{
+			name:         "Running tenant",
+			state:        StatusInitialized,
+			replicas:     4,
+			wantReplicas: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant := suspendTestTenant(tt.state)
+			c := newSuspendTestController(tenant, suspendTestStatefulSet(tenant, tt.replicas, tt.suspended))
+			syncStatefulSetCache(t, c)
+
+			got, resumed, err := c.resumeTenant(context.Background(), "ns/tenant", tenant)
+			if err != nil {
+				t.Fatalf("resumeTenant() error = %v", err)
+			}
+			if resumed != tt.wantResumed {
+				t.Errorf("resumeTenant() resumed = %v, want %v", resumed, tt.wantResumed)
+			}
+			checkStatefulSet(t, c, tt.wantReplicas, "")
+			wantState := tt.state
+			if tt.wantResumed {
+				wantState = StatusWaitingMinIOIsHealthy
+			}
+			if got.Status.CurrentState != wantState {
+				t.Errorf("tenant state = %s, want %s", got.Status.CurrentState, wantState)
+			}
+			if queued := c.healthCheckQueue.Len() == 1; queued != tt.wantResumed {
+				t.Errorf("health check queued = %v, want %v", queued, tt.wantResumed)
+			}
+		})
+	}
+}
+
+func TestResumePartiallySuspendedTenant(t *testing.T) {
+	tenant := suspendTestTenant(StatusInitialized)
+	c := newSuspendTestController(tenant, suspendTestStatefulSet(tenant, 4, ""))
+	c.minioClientSet.(*miniofake.Clientset).PrependReactor("update", "tenants", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &miniov2.Tenant{}, errors.New("status update failed")
+	})
+	syncStatefulSetCache(t, c)
+
+	// the pool is scaled down but the tenant is never marked as suspended
+	if _, err := c.suspendTenant(context.Background(), "ns/tenant", tenant.DeepCopy()); err == nil {
+		t.Fatal("suspendTenant() expected the status update to fail")
+	}
+	checkStatefulSet(t, c, 0, "4")
+
+	c.minioClientSet = miniofake.NewSimpleClientset(tenant.DeepCopy())
+	syncStatefulSetCache(t, c)
+	_, resumed, err := c.resumeTenant(context.Background(), "ns/tenant", tenant)
+	if err != nil {
+		t.Fatalf("resumeTenant() error = %v", err)
+	}
+	if !resumed {
+		t.Error("resumeTenant() must resume a partially suspended tenant")
+	}
+	checkStatefulSet(t, c, 4, "")
+}
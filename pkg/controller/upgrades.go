@@ -42,8 +42,9 @@ const (
 	version45  = "v4.5"
 	version500 = "v5.0.0"
 	version600 = "v6.0.0"
+	version610 = "v6.1.0"
 	// currentVersion will point to the latest released update version
-	currentVersion = version600
+	currentVersion = version610
 )
 
 // Legacy const
@@ -63,12 +64,13 @@ func (c *Controller) checkForUpgrades(ctx context.Context, tenant *miniov2.Tenan
 		version430: c.upgrade430,
 		version500: c.upgrade500,
 		version600: c.upgrade600,
+		version610: c.upgrade610,
 	}
 
 	// if tenant has no version we mark it with latest version upgrade released
 	if tenant.Status.SyncVersion == "" {
 		tenant.Status.SyncVersion = currentVersion
-		return c.updateTenantSyncVersion(ctx, tenant, version600)
+		return c.updateTenantSyncVersion(ctx, tenant, currentVersion)
 	}
 
 	// if the version is empty, upgrades might not been applied, we apply them all
@@ -86,6 +88,7 @@ func (c *Controller) checkForUpgrades(ctx context.Context, tenant *miniov2.Tenan
 			version45,
 			version500,
 			version600,
+			version610,
 		}
 		for _, v := range versionsThatNeedUpgrades {
 			vp, _ := version.NewVersion(v)
@@ -97,10 +100,12 @@ func (c *Controller) checkForUpgrades(ctx context.Context, tenant *miniov2.Tenan
 
 	for _, u := range upgradesToDo {
 		klog.Infof("Upgrading %s", u)
-		if tenant, err := upgrades[u](ctx, tenant); err != nil {
+		upgradedTenant, err := upgrades[u](ctx, tenant)
+		if err != nil {
 			klog.V(2).Infof("'%s/%s' Error upgrading tenant: %v", tenant.Namespace, tenant.Name, err.Error())
 			return tenant, err
 		}
+		tenant = upgradedTenant
 	}
 
 	return tenant, nil
@@ -378,3 +383,25 @@ func (c *Controller) upgrade600(ctx context.Context, tenant *miniov2.Tenant) (*m
 	}
 	return c.updateTenantSyncVersion(ctx, tenant, version600)
 }
+
+// Upgrades the sync version to v6.1.0
+// since multiple tenants are allowed per namespace the MinIO ClusterIP service is named after the tenant, existing
+// tenants keep their `minio` service
+func (c *Controller) upgrade610(ctx context.Context, tenant *miniov2.Tenant) (*miniov2.Tenant, error) {
+	svc, err := c.serviceLister.Services(tenant.Namespace).Get(miniov2.LegacyMinIOCIServiceName)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && metav1.IsControlledBy(svc, tenant) && tenant.Annotations[miniov2.MinIOServiceNameAnnotation] == "" {
+		tenant = tenant.DeepCopy()
+		if tenant.Annotations == nil {
+			tenant.Annotations = map[string]string{}
+		}
+		tenant.Annotations[miniov2.MinIOServiceNameAnnotation] = svc.Name
+		if tenant, err = c.minioClientSet.MinioV2().Tenants(tenant.Namespace).Update(ctx, tenant, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+		tenant.EnsureDefaults()
+	}
+	return c.updateTenantSyncVersion(ctx, tenant, version610)
+}
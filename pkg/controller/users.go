@@ -0,0 +1,324 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
+	"github.com/minio/operator/pkg/auth/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// generatedSecretKeyLength is the length of the secret keys generated for the users
+const generatedSecretKeyLength = 40
+
+// userAPI is the part of the MinIO admin client used to sync a user
+type userAPI interface {
+	policyAttachmentAPI
+	GetUserInfo(ctx context.Context, name string) (madmin.UserInfo, error)
+	SetUser(ctx context.Context, accessKey, secretKey string, status madmin.AccountStatus) error
+	SetUserStatus(ctx context.Context, accessKey string, status madmin.AccountStatus) error
+	RemoveUser(ctx context.Context, accessKey string) error
+}
+
+// enqueueUser takes a MinIOUser resource and puts its namespace/name key onto the user queue
+func (c *Controller) enqueueUser(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	if !c.namespacesToWatch.IsEmpty() {
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			runtime.HandleError(err)
+			return
+		}
+		if !c.namespacesToWatch.Contains(object.GetNamespace()) {
+			klog.Infof("Ignoring user `%s` in namespace that is not watched by this controller.", key)
+			return
+		}
+	}
+	c.userQueue.Add(key)
+}
+
+// runUserWorker processes the MinIOUsers of the user queue
+func (c *Controller) runUserWorker() {
+	defer runtime.HandleCrash()
+	for processNextItem(c.userQueue, c.syncUserHandler) {
+	}
+}
+
+// syncUserHandler creates or updates the user of a MinIOUser resource in its tenant
+func (c *Controller) syncUserHandler(key string) (Result, error) {
+	ctx := context.Background()
+	namespace, name := key2NamespaceName(key)
+	user, err := c.userLister.MinIOUsers(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, nil)
+		}
+		return WrapResult(Result{}, err)
+	}
+	user = user.DeepCopy()
+
+	tenant, err := c.minioClientSet.MinioV2().Tenants(namespace).Get(ctx, user.Spec.Tenant.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return WrapResult(Result{}, err)
+		}
+		tenant = nil
+	}
+
+	if user.DeletionTimestamp != nil {
+		return WrapResult(Result{}, c.finalizeUser(ctx, key, user, tenant))
+	}
+	if !controllerutil.ContainsFinalizer(user, iamv1alpha1.IAMFinalizer) {
+		controllerutil.AddFinalizer(user, iamv1alpha1.IAMFinalizer)
+		// the update queues the user again
+		_, err = c.minioClientSet.IamV1alpha1().MinIOUsers(namespace).Update(ctx, user, metav1.UpdateOptions{})
+		return WrapResult(Result{}, err)
+	}
+
+	if err = user.Validate(); err != nil {
+		return WrapResult(Result{}, c.updateUserReady(ctx, user, metav1.ConditionFalse, "InvalidSpec", err.Error()))
+	}
+	if tenant == nil {
+		msg := fmt.Sprintf("Tenant %s not found", user.Spec.Tenant.Name)
+		return WrapResult(Result{RequeueAfter: 30 * time.Second}, c.updateUserReady(ctx, user, metav1.ConditionFalse, "TenantNotFound", msg))
+	}
+	if tenant.Status.HealthStatus != miniov2.HealthStatusGreen {
+		msg := fmt.Sprintf("Waiting for tenant %s to be healthy", tenant.Name)
+		return WrapResult(Result{RequeueAfter: 10 * time.Second}, c.updateUserReady(ctx, user, metav1.ConditionFalse, "TenantNotReady", msg))
+	}
+
+	secret, err := c.userCredentialsSecret(ctx, user)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+	if secret == nil {
+		msg := fmt.Sprintf("Secret %s not found", user.SecretName())
+		return WrapResult(Result{RequeueAfter: 30 * time.Second}, c.updateUserReady(ctx, user, metav1.ConditionFalse, "SecretNotFound", msg))
+	}
+	secretKey, err := userSecretKey(user, secret)
+	if err != nil {
+		return WrapResult(Result{}, c.updateUserReady(ctx, user, metav1.ConditionFalse, "InvalidSecret", err.Error()))
+	}
+
+	adminClient, err := c.newTenantAdminClient(ctx, tenant)
+	if err != nil {
+		return WrapResult(Result{}, err)
+	}
+	credentialsChanged := secret.ResourceVersion != user.Status.CredentialsVersion
+	changed, err := syncUser(ctx, adminClient, user, secretKey, credentialsChanged)
+	if err != nil {
+		klog.Errorf("'%s' Failed to sync user %s: %v", key, user.UserName(), err)
+		c.recorder.Event(user, corev1.EventTypeWarning, "UserSyncFailed", err.Error())
+		if uerr := c.updateUserReady(ctx, user, metav1.ConditionFalse, "SyncFailed", err.Error()); uerr != nil {
+			return WrapResult(Result{}, uerr)
+		}
+		return WrapResult(Result{}, err)
+	}
+
+	// the generation and the credentials were already synced, so the user changed in MinIO
+	if len(changed) > 0 && user.Status.ObservedGeneration == user.Generation && !credentialsChanged {
+		msg := fmt.Sprintf("Restored the settings changed outside of the MinIOUser resource: %s", strings.Join(changed, ", "))
+		klog.Infof("'%s' %s", key, msg)
+		c.recorder.Event(user, corev1.EventTypeWarning, "UserDrift", msg)
+		now := metav1.Now()
+		user.Status.LastDriftTime = &now
+	} else if len(changed) > 0 {
+		c.recorder.Event(user, corev1.EventTypeNormal, "UserSynced", fmt.Sprintf("User %s synced: %s", user.UserName(), strings.Join(changed, ", ")))
+	}
+	user.Status.Name = user.UserName()
+	user.Status.ObservedGeneration = user.Generation
+	user.Status.CredentialsSecret = secret.Name
+	user.Status.CredentialsVersion = secret.ResourceVersion
+	if err = c.updateUserReady(ctx, user, metav1.ConditionTrue, "UserSynced", "The user matches its spec"); err != nil {
+		return WrapResult(Result{}, err)
+	}
+	// the user can be changed outside of the operator, check it again from time to time
+	return WrapResult(Result{RequeueAfter: iamResyncPeriod}, nil)
+}
+
+// userCredentialsSecret returns the Secret holding the credentials of the user. The Secret is generated, and its
+// secret key rotated when asked to, if the user doesn't have its own. Returns nil if the Secret of the user doesn't
+// exist.
+func (c *Controller) userCredentialsSecret(ctx context.Context, user *iamv1alpha1.MinIOUser) (*corev1.Secret, error) {
+	secrets := c.kubeClientSet.CoreV1().Secrets(user.Namespace)
+	secret, err := secrets.Get(ctx, user.SecretName(), metav1.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if !user.GeneratesCredentials() {
+		if err != nil {
+			return nil, nil
+		}
+		return secret, nil
+	}
+	rotation := user.Annotations[iamv1alpha1.RotateCredentialsAnnotation]
+	if err != nil {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            user.SecretName(),
+				Namespace:       user.Namespace,
+				OwnerReferences: user.OwnerRef(),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				iamv1alpha1.AccessKeyField: []byte(user.UserName()),
+				iamv1alpha1.SecretKeyField: []byte(utils.RandomCharString(generatedSecretKeyLength)),
+			},
+		}
+		if secret, err = secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return nil, err
+		}
+		c.recorder.Event(user, corev1.EventTypeNormal, "CredentialsGenerated", fmt.Sprintf("Generated the credentials of the user in Secret %s", secret.Name))
+	} else if rotation != user.Status.CredentialsRotation {
+		secret = secret.DeepCopy()
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[iamv1alpha1.AccessKeyField] = []byte(user.UserName())
+		secret.Data[iamv1alpha1.SecretKeyField] = []byte(utils.RandomCharString(generatedSecretKeyLength))
+		if secret, err = secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+		c.recorder.Event(user, corev1.EventTypeNormal, "CredentialsRotated", fmt.Sprintf("Rotated the credentials of the user in Secret %s", secret.Name))
+	}
+	user.Status.CredentialsRotation = rotation
+	return secret, nil
+}
+
+// userSecretKey returns the secret key of the user from its Secret
+func userSecretKey(user *iamv1alpha1.MinIOUser, secret *corev1.Secret) (string, error) {
+	if accessKey, ok := secret.Data[iamv1alpha1.AccessKeyField]; ok && strings.TrimSpace(string(accessKey)) != user.UserName() {
+		return "", fmt.Errorf("%s of Secret %s doesn't match the user name %s", iamv1alpha1.AccessKeyField, secret.Name, user.UserName())
+	}
+	secretKey := strings.TrimSpace(string(secret.Data[iamv1alpha1.SecretKeyField]))
+	if len(secretKey) < 8 {
+		return "", fmt.Errorf("%s of Secret %s must be at least 8 characters long", iamv1alpha1.SecretKeyField, secret.Name)
+	}
+	return secretKey, nil
+}
+
+// finalizeUser removes the user of a deleted MinIOUser from its tenant, the generated Secret is garbage collected
+func (c *Controller) finalizeUser(ctx context.Context, key string, user *iamv1alpha1.MinIOUser, tenant *miniov2.Tenant) error {
+	if !controllerutil.ContainsFinalizer(user, iamv1alpha1.IAMFinalizer) {
+		return nil
+	}
+	// the user is gone along with a deleted tenant
+	if user.Status.Name != "" && tenant != nil && tenant.DeletionTimestamp == nil {
+		adminClient, err := c.newTenantAdminClient(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if err = removeUser(ctx, adminClient, user.Status.Name); err != nil {
+			return err
+		}
+		klog.Infof("'%s' Removed user %s", key, user.Status.Name)
+	}
+	controllerutil.RemoveFinalizer(user, iamv1alpha1.IAMFinalizer)
+	if _, err := c.minioClientSet.IamV1alpha1().MinIOUsers(user.Namespace).Update(ctx, user, metav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// updateUserReady sets the Ready condition of the user and persists its status if it changed
+func (c *Controller) updateUserReady(ctx context.Context, user *iamv1alpha1.MinIOUser, status metav1.ConditionStatus, reason, msg string) error {
+	current, err := c.userLister.MinIOUsers(user.Namespace).Get(user.Name)
+	if err != nil {
+		return err
+	}
+	updated := user.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+		Type:               iamv1alpha1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: user.Generation,
+	})
+	if equality.Semantic.DeepEqual(current.Status, updated.Status) {
+		return nil
+	}
+	_, err = c.minioClientSet.IamV1alpha1().MinIOUsers(user.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// syncUser creates the user in MinIO and applies its status and policies. The secret key is only set when the user is
+// created or its credentials changed. Returns the settings that were changed.
+func syncUser(ctx context.Context, api userAPI, user *iamv1alpha1.MinIOUser, secretKey string, credentialsChanged bool) ([]string, error) {
+	name := user.UserName()
+	status := madmin.AccountEnabled
+	if user.Spec.Disabled {
+		status = madmin.AccountDisabled
+	}
+	var changed []string
+
+	info, err := api.GetUserInfo(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchUser" {
+		return nil, err
+	}
+	switch {
+	case err != nil:
+		if err = api.SetUser(ctx, name, secretKey, status); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "user")
+	case credentialsChanged:
+		if err = api.SetUser(ctx, name, secretKey, status); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "credentials")
+	case info.Status != status:
+		if err = api.SetUserStatus(ctx, name, status); err != nil {
+			return nil, err
+		}
+		changed = append(changed, "status")
+	}
+
+	policiesChanged, err := syncAttachedPolicies(ctx, api, info.PolicyName, user.Spec.Policies, name, "")
+	if err != nil {
+		return nil, err
+	}
+	if policiesChanged {
+		changed = append(changed, "policies")
+	}
+	return changed, nil
+}
+
+// removeUser removes the user from MinIO, a user that is already gone isn't an error
+func removeUser(ctx context.Context, api userAPI, name string) error {
+	err := api.RemoveUser(ctx, name)
+	if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchUser" {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2024, MinIO, Inc.
+//
+// This code is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License, version 3,
+// as published by the Free Software Foundation.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License, version 3,
+// along with this program.  If not, see <http://www.gnu.org/licenses/>
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/set"
+	iamv1alpha1 "github.com/minio/operator/pkg/apis/iam.min.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeIAMAPI keeps the users, groups and their policies in memory
+type fakeIAMAPI struct {
+	users    map[string]madmin.UserInfo
+	groups   map[string]*madmin.GroupDesc
+	policies map[string]set.StringSet
+}
+
+func newFakeIAMAPI() *fakeIAMAPI {
+	return &fakeIAMAPI{users: map[string]madmin.UserInfo{}, groups: map[string]*madmin.GroupDesc{}, policies: map[string]set.StringSet{}}
+}
+
+func (f *fakeIAMAPI) entity(r madmin.PolicyAssociationReq) string {
+	if r.User != "" {
+		return "user:" + r.User
+	}
+	return "group:" + r.Group
+}
+
+func (f *fakeIAMAPI) attached(entity string) string {
+	policies := f.policies[entity].ToSlice()
+	sort.Strings(policies)
+	return strings.Join(policies, ",")
+}
+
+func (f *fakeIAMAPI) AttachPolicy(_ context.Context, r madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	entity := f.entity(r)
+	if f.policies[entity] == nil {
+		f.policies[entity] = set.NewStringSet()
+	}
+	for _, policy := range r.Policies {
+		f.policies[entity].Add(policy)
+	}
+	return madmin.PolicyAssociationResp{PoliciesAttached: r.Policies}, nil
+}
+
+func (f *fakeIAMAPI) DetachPolicy(_ context.Context, r madmin.PolicyAssociationReq) (madmin.PolicyAssociationResp, error) {
+	entity := f.entity(r)
+	for _, policy := range r.Policies {
+		f.policies[entity].Remove(policy)
+	}
+	return madmin.PolicyAssociationResp{PoliciesDetached: r.Policies}, nil
+}
+
+func (f *fakeIAMAPI) GetUserInfo(_ context.Context, name string) (madmin.UserInfo, error) {
+	info, ok := f.users[name]
+	if !ok {
+		return madmin.UserInfo{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchUser"}
+	}
+	info.PolicyName = f.attached("user:" + name)
+	return info, nil
+}
+
+func (f *fakeIAMAPI) SetUser(_ context.Context, accessKey, secretKey string, status madmin.AccountStatus) error {
+	f.users[accessKey] = madmin.UserInfo{SecretKey: secretKey, Status: status}
+	return nil
+}
+
+func (f *fakeIAMAPI) SetUserStatus(_ context.Context, accessKey string, status madmin.AccountStatus) error {
+	info := f.users[accessKey]
+	info.Status = status
+	f.users[accessKey] = info
+	return nil
+}
+
+func (f *fakeIAMAPI) RemoveUser(_ context.Context, accessKey string) error {
+	if _, ok := f.users[accessKey]; !ok {
+		return madmin.ErrorResponse{Code: "XMinioAdminNoSuchUser"}
+	}
+	delete(f.users, accessKey)
+	return nil
+}
+
+func TestSyncUser(t *testing.T) {
+	user := &iamv1alpha1.MinIOUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Spec: iamv1alpha1.MinIOUserSpec{
+			Tenant:   iamv1alpha1.TenantReference{Name: "myminio"},
+			Policies: []string{"read-data", "readonly"},
+		},
+	}
+	api := newFakeIAMAPI()
+	ctx := context.Background()
+
+	changed, err := syncUser(ctx, api, user, "secret-key", true)
+	if err != nil {
+		t.Fatalf("syncUser() error = %v", err)
+	}
+	if want := []string{"user", "policies"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncUser() changed = %v, want %v", changed, want)
+	}
+	if api.users["app"].SecretKey != "secret-key" || api.attached("user:app") != "read-data,readonly" {
+		t.Errorf("syncUser() didn't create the user: %+v", api.users["app"])
+	}
+
+	if changed, err = syncUser(ctx, api, user, "secret-key", false); err != nil || len(changed) != 0 {
+		t.Errorf("syncUser() of a user in sync changed = %v, error = %v", changed, err)
+	}
+
+	user.Spec.Disabled = true
+	user.Spec.Policies = []string{"read-data"}
+	changed, err = syncUser(ctx, api, user, "rotated-key", true)
+	if err != nil {
+		t.Fatalf("syncUser() error = %v", err)
+	}
+	if want := []string{"credentials", "policies"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("syncUser() changed = %v, want %v", changed, want)
+	}
+	if info := api.users["app"]; info.SecretKey != "rotated-key" || info.Status != madmin.AccountDisabled || api.attached("user:app") != "read-data" {
+		t.Errorf("syncUser() didn't update the user: %+v, policies %s", info, api.attached("user:app"))
+	}
+
+	if err = removeUser(ctx, api, "app"); err != nil || len(api.users) != 0 {
+		t.Errorf("removeUser() = %v, users %v", err, api.users)
+	}
+	if err = removeUser(ctx, api, "app"); err != nil {
+		t.Errorf("removeUser() of a missing user = %v", err)
+	}
+}
+
+func TestUserSecretKey(t *testing.T) {
+	user := &iamv1alpha1.MinIOUser{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    string
+		wantErr bool
+	}{
+		{name: "secret key only", data: map[string]string{"CONSOLE_SECRET_KEY": "secret-key\n"}, want: "secret-key"},
+		{name: "matching access key", data: map[string]string{"CONSOLE_ACCESS_KEY": "app", "CONSOLE_SECRET_KEY": "secret-key"}, want: "secret-key"},
+		{name: "other access key", data: map[string]string{"CONSOLE_ACCESS_KEY": "other", "CONSOLE_SECRET_KEY": "secret-key"}, wantErr: true},
+		{name: "short secret key", data: map[string]string{"CONSOLE_SECRET_KEY": "secret"}, wantErr: true},
+		{name: "missing secret key", data: map[string]string{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "app-credentials"}, Data: map[string][]byte{}}
+			for k, v := range tt.data {
+				secret.Data[k] = []byte(v)
+			}
+			got, err := userSecretKey(user, secret)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("userSecretKey() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@
 package statefulsets
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"sort"
@@ -124,24 +127,18 @@ var TmpCfgVolumeMount = corev1.VolumeMount{
 
 // Builds the volume mounts for MinIO container.
 func volumeMounts(t *miniov2.Tenant, pool *miniov2.Pool, certVolumeSources []corev1.VolumeProjection) (mounts []corev1.VolumeMount) {
-	// Default volume name, unless another one was provided
-	name := miniov2.MinIOVolumeName
-	if pool.VolumeClaimTemplate != nil {
-		name = pool.VolumeClaimTemplate.Name
-	}
-
 	// shared configuration Volume
 	mounts = append(mounts, CfgVolumeMount)
 
 	if pool.VolumesPerServer == 1 {
 		mounts = append(mounts, corev1.VolumeMount{
-			Name:      name + strconv.Itoa(0),
+			Name:      pool.VolumeName(0),
 			MountPath: t.Spec.Mountpath,
 		})
 	} else {
 		for i := 0; i < int(pool.VolumesPerServer); i++ {
 			mounts = append(mounts, corev1.VolumeMount{
-				Name:      name + strconv.Itoa(i),
+				Name:      pool.VolumeName(i),
 				MountPath: t.Spec.Mountpath + strconv.Itoa(i),
 			})
 		}
@@ -208,7 +205,7 @@ func poolMinioServerContainer(t *miniov2.Tenant, skipEnvVars map[string][]byte,
 
 	return corev1.Container{
 		Name:            miniov2.MinIOServerName,
-		Image:           t.Spec.Image,
+		Image:           t.MinIOImage(),
 		Ports:           containerPorts,
 		ImagePullPolicy: t.Spec.ImagePullPolicy,
 		VolumeMounts:    volumeMounts(t, pool, certVolumeSources),
@@ -226,7 +223,8 @@ func poolMinioServerContainer(t *miniov2.Tenant, skipEnvVars map[string][]byte,
 // GetContainerArgs returns the arguments that the MinIO container receives
 func GetContainerArgs(t *miniov2.Tenant, hostsTemplate string) []string {
 	var args []string
-	if len(t.Spec.Pools) == 1 && t.Spec.Pools[0].Servers == 1 {
+	decommissioning := t.DecommissioningPools()
+	if len(t.Spec.Pools) == 1 && t.Spec.Pools[0].Servers == 1 && len(decommissioning) == 0 {
 		// to run in standalone mode we must pass the path
 		args = append(args, t.VolumePathForPool(&t.Spec.Pools[0]))
 	} else {
@@ -234,9 +232,46 @@ func GetContainerArgs(t *miniov2.Tenant, hostsTemplate string) []string {
 			args = append(args, fmt.Sprintf("%s%s", endpoint, t.VolumePathForPool(&t.Spec.Pools[index])))
 		}
 	}
+	// pools being decommissioned must remain in the arguments, at the same position, until they are drained
+	for _, pool := range decommissioning {
+		index := int(pool.Decommission.Index)
+		if index < 0 || index > len(args) {
+			index = len(args)
+		}
+		args = append(args[:index], append([]string{pool.Decommission.CmdLine}, args[index:]...)...)
+	}
 	return args
 }
 
+// Builds the update strategy for a Pool. While a rolling upgrade is in progress the partition keeps the pods
+// the operator didn't restart yet on the previous version.
+func poolUpdateStrategy(t *miniov2.Tenant, pool *miniov2.Pool) appsv1.StatefulSetUpdateStrategy {
+	upgrade := t.Status.RollingUpgrade
+	if upgrade == nil {
+		return appsv1.StatefulSetUpdateStrategy{}
+	}
+	partition := pool.Servers
+	for _, p := range t.Spec.Pools {
+		if p.Name == upgrade.Pool {
+			if p.Name == pool.Name {
+				partition = upgrade.Partition
+			}
+			break
+		}
+		if p.Name == pool.Name {
+			// pools before the one being upgraded are already running the new version
+			partition = 0
+			break
+		}
+	}
+	return appsv1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+			Partition: &partition,
+		},
+	}
+}
+
 // Builds the tolerations for a Pool.
 func poolTolerations(z *miniov2.Pool) []corev1.Toleration {
 	var tolerations []corev1.Toleration
@@ -668,6 +703,7 @@ func NewPool(args *NewPoolArgs) *appsv1.StatefulSet {
 			Selector:            ContainerMatchLabels(t, pool),
 			ServiceName:         serviceName,
 			Replicas:            &replicas,
+			UpdateStrategy:      poolUpdateStrategy(t, pool),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: PodMetadata(t, pool),
 				Spec: corev1.PodSpec{
@@ -695,11 +731,14 @@ func NewPool(args *NewPoolArgs) *appsv1.StatefulSet {
 		ss.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{t.Spec.ImagePullSecret}
 	}
 
-	if pool.VolumeClaimTemplate != nil {
-		pvClaim := *pool.VolumeClaimTemplate
-		name := pvClaim.Name
+	if pool.HasVolumeClaimTemplate() {
 		for i := 0; i < int(pool.VolumesPerServer); i++ {
-			pvClaim.Name = name + strconv.Itoa(i)
+			template := pool.VolumeClaimTemplateFor(i)
+			if template == nil {
+				continue
+			}
+			pvClaim := *template
+			pvClaim.Name = pool.VolumeName(i)
 			ss.Spec.VolumeClaimTemplates = append(ss.Spec.VolumeClaimTemplates, pvClaim)
 		}
 	}
@@ -726,9 +765,25 @@ func NewPool(args *NewPoolArgs) *appsv1.StatefulSet {
 		}
 		ss.Spec.Template.Spec.Volumes = append(ss.Spec.Template.Spec.Volumes, t.Spec.AdditionalVolumes...)
 	}
+	// the stored statefulset is defaulted by the API server, keep a hash of what was generated to detect changes
+	ss.Annotations = miniov2.MergeMaps(map[string]string{}, ss.Annotations)
+	ss.Annotations[miniov2.PoolTemplateHashAnnotation] = PoolTemplateHash(ss)
 	return ss
 }
 
+// PoolTemplateHash returns the hash of the pod template and update strategy of a pool statefulset
+func PoolTemplateHash(ss *appsv1.StatefulSet) string {
+	data, err := json.Marshal(struct {
+		Template       corev1.PodTemplateSpec
+		UpdateStrategy appsv1.StatefulSetUpdateStrategy
+	}{ss.Spec.Template, ss.Spec.UpdateStrategy})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 func getInitContainer(t *miniov2.Tenant, pool *miniov2.Pool) corev1.Container {
 	initContainer := corev1.Container{
 		Name:  "validate-arguments",
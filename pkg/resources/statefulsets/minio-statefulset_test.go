@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	miniov2 "github.com/minio/operator/pkg/apis/minio.min.io/v2"
@@ -90,6 +91,47 @@ func TestGetContainerArgs(t *testing.T) {
 				"https://minio-pool-0-{0...3}.minio-hl..svc.cluster.local/export{0...3}",
 			},
 		},
+		{
+			name: "Tenant Decommissioning A Pool",
+			args: args{
+				t: &miniov2.Tenant{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "minio",
+					},
+					Spec: miniov2.TenantSpec{
+						Pools: []miniov2.Pool{
+							{
+								Name:                "pool-1",
+								Servers:             4,
+								VolumesPerServer:    4,
+								VolumeClaimTemplate: nil,
+							},
+						},
+					},
+					Status: miniov2.TenantStatus{
+						Pools: []miniov2.PoolStatus{
+							{
+								SSName: "minio-pool-1",
+								State:  miniov2.PoolInitialized,
+							},
+							{
+								SSName: "minio-pool-0",
+								State:  miniov2.PoolDecommissioning,
+								Decommission: &miniov2.PoolDecommissionStatus{
+									Index:   0,
+									CmdLine: "https://minio-pool-0-{0...3}.minio-hl..svc.cluster.local/export{0...3}",
+								},
+							},
+						},
+					},
+				},
+				hostsTemplate: "",
+			},
+			want: []string{
+				"https://minio-pool-0-{0...3}.minio-hl..svc.cluster.local/export{0...3}",
+				"https://minio-pool-1-{0...3}.minio-hl..svc.cluster.local/export{0...3}",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -102,3 +144,57 @@ func TestGetContainerArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolUpdateStrategy(t *testing.T) {
+	tenant := &miniov2.Tenant{
+		Spec: miniov2.TenantSpec{
+			Pools: []miniov2.Pool{
+				{Name: "pool-0", Servers: 4},
+				{Name: "pool-1", Servers: 4},
+				{Name: "pool-2", Servers: 4},
+			},
+		},
+		Status: miniov2.TenantStatus{
+			RollingUpgrade: &miniov2.RollingUpgradeStatus{
+				Pool:      "pool-1",
+				Partition: 2,
+			},
+		},
+	}
+	want := []int32{0, 2, 4}
+	for i := range tenant.Spec.Pools {
+		strategy := poolUpdateStrategy(tenant, &tenant.Spec.Pools[i])
+		if strategy.RollingUpdate == nil || strategy.RollingUpdate.Partition == nil {
+			t.Fatalf("poolUpdateStrategy() for %s has no partition", tenant.Spec.Pools[i].Name)
+		}
+		if got := *strategy.RollingUpdate.Partition; got != want[i] {
+			t.Errorf("poolUpdateStrategy() partition for %s = %d, want %d", tenant.Spec.Pools[i].Name, got, want[i])
+		}
+	}
+
+	tenant.Status.RollingUpgrade = nil
+	if strategy := poolUpdateStrategy(tenant, &tenant.Spec.Pools[0]); strategy.RollingUpdate != nil {
+		t.Errorf("poolUpdateStrategy() = %v, want the default strategy", strategy)
+	}
+}
+
+func TestVolumeMounts(t *testing.T) {
+	tenant := &miniov2.Tenant{Spec: miniov2.TenantSpec{Mountpath: "/export"}}
+	pool := &miniov2.Pool{
+		Servers:          4,
+		VolumesPerServer: 3,
+		VolumeClaimTemplates: []miniov2.PoolVolumeClaimTemplate{
+			{Count: 1, Template: corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "nvme"}}},
+			{Count: 2, Template: corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "hdd"}}},
+		},
+	}
+	want := []corev1.VolumeMount{
+		CfgVolumeMount,
+		{Name: "nvme0", MountPath: "/export0"},
+		{Name: "hdd1", MountPath: "/export1"},
+		{Name: "hdd2", MountPath: "/export2"},
+	}
+	if got := volumeMounts(tenant, pool, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("volumeMounts() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,391 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package signature verifies the signatures of MinIO images, cosign signatures stored next to the image in the
+// registry and minisign signatures of the MinIO binary.
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// Annotations cosign stores on each signature layer
+const (
+	CosignSignatureAnnotation   = "dev.cosignproject.cosign/signature"
+	CosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	CosignChainAnnotation       = "dev.sigstore.cosign/chain"
+	CosignBundleAnnotation      = "dev.sigstore.cosign/bundle"
+)
+
+var (
+	// oidcIssuerOID is the legacy extension holding the OIDC issuer of Fulcio certificates
+	oidcIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidcIssuerV2OID is the extension holding the DER encoded OIDC issuer of Fulcio certificates
+	oidcIssuerV2OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// ErrNoSignatures is returned when the image has no cosign signatures
+var ErrNoSignatures = errors.New("no cosign signatures found for the image")
+
+// KeylessIdentity is a signer accepted for cosign keyless signatures
+type KeylessIdentity struct {
+	Issuer  string
+	Subject string
+}
+
+// CosignVerifier verifies the cosign signatures of container images
+type CosignVerifier struct {
+	// PublicKeys accepted for signatures made with a key
+	PublicKeys []crypto.PublicKey
+	// Roots of the certificate authority issuing the certificates of keyless signatures
+	Roots *x509.CertPool
+	// TransparencyLogKey verifies the transparency log bundle of keyless signatures
+	TransparencyLogKey crypto.PublicKey
+	// Identities accepted for keyless signatures
+	Identities []KeylessIdentity
+}
+
+// cosignPayload is the simple signing payload cosign signs
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// cosignBundle is the transparency log entry attached to keyless signatures
+type cosignBundle struct {
+	SignedEntryTimestamp []byte `json:"SignedEntryTimestamp"`
+	Payload              struct {
+		Body           interface{} `json:"body"`
+		IntegratedTime int64       `json:"integratedTime"`
+		LogIndex       int64       `json:"logIndex"`
+		LogID          string      `json:"logID"`
+	} `json:"Payload"`
+}
+
+// hashedRekord is the transparency log entry body recording a signature, its certificate and the signed hash
+type hashedRekord struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   []byte `json:"content"`
+			PublicKey struct {
+				Content []byte `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// ParsePublicKey parses a PEM encoded public key
+func ParsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("invalid PEM public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// ParseCertificates parses all the PEM encoded certificates in data
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM certificates found")
+	}
+	return certs, nil
+}
+
+// Verify checks that the image has at least one cosign signature made by one of the public keys or keyless
+// identities of the verifier
+func (v *CosignVerifier) Verify(ref name.Reference, options ...remote.Option) error {
+	desc, err := remote.Head(ref, options...)
+	if err != nil {
+		return fmt.Errorf("unable to resolve the image digest: %w", err)
+	}
+	sigRef := ref.Context().Tag(strings.Replace(desc.Digest.String(), ":", "-", 1) + ".sig")
+	sigImage, err := remote.Image(sigRef, options...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return ErrNoSignatures
+		}
+		return fmt.Errorf("unable to fetch the image signatures: %w", err)
+	}
+	manifest, err := sigImage.Manifest()
+	if err != nil {
+		return err
+	}
+	if len(manifest.Layers) == 0 {
+		return ErrNoSignatures
+	}
+
+	var errs []string
+	for _, layer := range manifest.Layers {
+		if err = v.verifyLayer(sigImage, layer, desc.Digest); err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return fmt.Errorf("no valid cosign signature found: %s", strings.Join(errs, "; "))
+}
+
+// verifyLayer verifies one of the signatures stored on the cosign signature image
+func (v *CosignVerifier) verifyLayer(sigImage v1.Image, layer v1.Descriptor, digest v1.Hash) error {
+	sig, err := base64.StdEncoding.DecodeString(layer.Annotations[CosignSignatureAnnotation])
+	if err != nil || len(sig) == 0 {
+		return errors.New("signature annotation missing or invalid")
+	}
+	blob, err := sigImage.LayerByDigest(layer.Digest)
+	if err != nil {
+		return err
+	}
+	rc, err := blob.Compressed()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	payload, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+
+	var p cosignPayload
+	if err = json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest.String() {
+		return fmt.Errorf("signature is for digest %s, not %s", p.Critical.Image.DockerManifestDigest, digest)
+	}
+
+	if certPEM, ok := layer.Annotations[CosignCertificateAnnotation]; ok && certPEM != "" {
+		return v.verifyKeyless(payload, sig, layer.Annotations)
+	}
+	for _, key := range v.PublicKeys {
+		if verifySignature(key, payload, sig) == nil {
+			return nil
+		}
+	}
+	return errors.New("signature doesn't match any of the public keys")
+}
+
+// verifyKeyless verifies a signature made with a short-lived certificate issued to one of the identities of the verifier
+func (v *CosignVerifier) verifyKeyless(payload, sig []byte, annotations map[string]string) error {
+	if v.Roots == nil || v.TransparencyLogKey == nil || len(v.Identities) == 0 {
+		return errors.New("keyless signatures are not accepted")
+	}
+	certs, err := ParseCertificates([]byte(annotations[CosignCertificateAnnotation]))
+	if err != nil {
+		return fmt.Errorf("invalid signing certificate: %w", err)
+	}
+	cert := certs[0]
+
+	// the certificate is only valid for a few minutes, the transparency log tells when the signature was made
+	bundleJSON, ok := annotations[CosignBundleAnnotation]
+	if !ok {
+		return errors.New("keyless signature has no transparency log bundle")
+	}
+	var bundle cosignBundle
+	if err = json.Unmarshal([]byte(bundleJSON), &bundle); err != nil {
+		return fmt.Errorf("invalid transparency log bundle: %w", err)
+	}
+	if err = verifyBundle(v.TransparencyLogKey, &bundle); err != nil {
+		return err
+	}
+	// the entry must be the one of this signature, otherwise the bundle of any signature would date it
+	if err = verifyBundleBody(&bundle, payload, sig, cert); err != nil {
+		return err
+	}
+	signedAt := time.Unix(bundle.Payload.IntegratedTime, 0)
+
+	intermediates := x509.NewCertPool()
+	if chain, ok := annotations[CosignChainAnnotation]; ok && chain != "" {
+		chainCerts, err := ParseCertificates([]byte(chain))
+		if err != nil {
+			return fmt.Errorf("invalid certificate chain: %w", err)
+		}
+		for _, c := range chainCerts {
+			intermediates.AddCert(c)
+		}
+	}
+	if _, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("untrusted signing certificate: %w", err)
+	}
+	if !v.matchesIdentity(cert) {
+		return errors.New("signing certificate doesn't match any of the accepted identities")
+	}
+	return verifySignature(cert.PublicKey, payload, sig)
+}
+
+// matchesIdentity checks if the certificate was issued to one of the identities of the verifier
+func (v *CosignVerifier) matchesIdentity(cert *x509.Certificate) bool {
+	issuer := certificateIssuer(cert)
+	var subjects []string
+	subjects = append(subjects, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		subjects = append(subjects, uri.String())
+	}
+	for _, identity := range v.Identities {
+		if identity.Issuer != issuer {
+			continue
+		}
+		for _, subject := range subjects {
+			if subject == identity.Subject {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// certificateIssuer returns the OIDC issuer recorded on a Fulcio certificate
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidcIssuerV2OID) {
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &issuer, "utf8"); err == nil {
+				return issuer
+			}
+		}
+	}
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidcIssuerOID) {
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+// verifyBundle checks the signed entry timestamp of the transparency log over the canonical JSON of its payload
+func verifyBundle(key crypto.PublicKey, bundle *cosignBundle) error {
+	// json.Marshal sorts the keys of maps, giving the canonical form the transparency log signs
+	canonical, err := json.Marshal(map[string]interface{}{
+		"body":           bundle.Payload.Body,
+		"integratedTime": bundle.Payload.IntegratedTime,
+		"logIndex":       bundle.Payload.LogIndex,
+		"logID":          bundle.Payload.LogID,
+	})
+	if err != nil {
+		return err
+	}
+	if err = verifySignature(key, canonical, bundle.SignedEntryTimestamp); err != nil {
+		return fmt.Errorf("invalid transparency log bundle: %w", err)
+	}
+	return nil
+}
+
+// verifyBundleBody checks that the transparency log entry records the signature, the certificate and the hash of the
+// payload that were verified
+func verifyBundleBody(bundle *cosignBundle, payload, sig []byte, cert *x509.Certificate) error {
+	encoded, ok := bundle.Payload.Body.(string)
+	if !ok {
+		return errors.New("invalid transparency log entry")
+	}
+	body, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	var entry hashedRekord
+	if err = json.Unmarshal(body, &entry); err != nil {
+		return fmt.Errorf("invalid transparency log entry: %w", err)
+	}
+	if entry.Kind != "hashedrekord" {
+		return fmt.Errorf("unsupported transparency log entry kind %q", entry.Kind)
+	}
+	digest := sha256.Sum256(payload)
+	if entry.Spec.Data.Hash.Algorithm != "sha256" || entry.Spec.Data.Hash.Value != hex.EncodeToString(digest[:]) {
+		return errors.New("transparency log entry is for another payload")
+	}
+	if !bytes.Equal(entry.Spec.Signature.Content, sig) {
+		return errors.New("transparency log entry is for another signature")
+	}
+	certs, err := ParseCertificates(entry.Spec.Signature.PublicKey.Content)
+	if err != nil || !certs[0].Equal(cert) {
+		return errors.New("transparency log entry is for another certificate")
+	}
+	return nil
+}
+
+// verifySignature verifies the signature of the message the way cosign signs it for each type of key
+func verifySignature(key crypto.PublicKey, message, sig []byte) error {
+	digest := sha256.Sum256(message)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if ecdsa.VerifyASN1(k, digest[:], sig) {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil {
+			return nil
+		}
+	case ed25519.PublicKey:
+		if ed25519.Verify(k, message, sig) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+	return errors.New("signature verification failed")
+}
@@ -0,0 +1,360 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// pushImage pushes a random image to the registry and returns its reference
+func pushImage(t *testing.T, host, repository string) name.Reference {
+	t.Helper()
+	ref, err := name.ParseReference(fmt.Sprintf("%s/%s:RELEASE.2024-08-03T04-33-23Z", host, repository))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := random.Image(1024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = remote.Write(ref, img); err != nil {
+		t.Fatal(err)
+	}
+	return ref
+}
+
+// signImage pushes a cosign signature of the image made with the key, the way `cosign sign --key` stores it
+func signImage(t *testing.T, ref name.Reference, key *ecdsa.PrivateKey) {
+	t.Helper()
+	desc, err := remote.Head(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`,
+		ref.Context().Name(), desc.Digest))
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer := static.NewLayer(payload, types.MediaType("application/vnd.dev.cosign.simplesigning.v1+json"))
+	sigImage, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: layer,
+		Annotations: map[string]string{
+			CosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = remote.Write(ref.Context().Tag(sigTag(desc.Digest)), sigImage); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCosignVerifier_Verify(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := pushImage(t, host, "minio/signed")
+	signImage(t, signed, signingKey)
+	unsigned := pushImage(t, host, "minio/unsigned")
+
+	tests := []struct {
+		name    string
+		ref     name.Reference
+		keys    []crypto.PublicKey
+		wantErr bool
+	}{
+		{
+			name: "signed with the key",
+			ref:  signed,
+			keys: []crypto.PublicKey{&signingKey.PublicKey},
+		},
+		{
+			name: "signed with one of the keys",
+			ref:  signed,
+			keys: []crypto.PublicKey{&otherKey.PublicKey, &signingKey.PublicKey},
+		},
+		{
+			name:    "signed with another key",
+			ref:     signed,
+			keys:    []crypto.PublicKey{&otherKey.PublicKey},
+			wantErr: true,
+		},
+		{
+			name:    "not signed",
+			ref:     unsigned,
+			keys:    []crypto.PublicKey{&signingKey.PublicKey},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := &CosignVerifier{PublicKeys: tt.keys}
+			if err := verifier.Verify(tt.ref); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// The signature of an image can't be reused for another image
+func TestCosignVerifier_VerifyDigest(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := pushImage(t, host, "minio/minio")
+	signImage(t, signed, key)
+	signedDesc, err := remote.Head(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// replace the image, and move the signature over to the new digest
+	replaced := pushImage(t, host, "minio/minio")
+	replacedDesc, err := remote.Head(replaced)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigImage, err := remote.Image(signed.Context().Tag(sigTag(signedDesc.Digest)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = remote.Write(replaced.Context().Tag(sigTag(replacedDesc.Digest)), sigImage); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := &CosignVerifier{PublicKeys: []crypto.PublicKey{&key.PublicKey}}
+	if err = verifier.Verify(replaced); err == nil {
+		t.Error("Verify() accepted the signature of another image")
+	}
+}
+
+func sigTag(digest v1.Hash) string {
+	return strings.Replace(digest.String(), ":", "-", 1) + ".sig"
+}
+
+// keylessSignature signs the payload with a certificate issued by the CA to the identity, and records the signature
+// in a transparency log bundle signed with the log key, the way `cosign sign` stores a keyless signature
+func keylessSignature(t *testing.T, ca *x509.Certificate, caKey, logKey *ecdsa.PrivateKey, payload []byte, subject string) ([]byte, map[string]string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := asn1.MarshalWithParams("https://token.actions.githubusercontent.com", "utf8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       now.Add(-time.Minute),
+		NotAfter:        now.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{subject},
+		ExtraExtensions: []pkix.Extension{{Id: oidcIssuerV2OID, Value: issuer}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]interface{}{
+			"data":      map[string]interface{}{"hash": map[string]string{"algorithm": "sha256", "value": hex.EncodeToString(digest[:])}},
+			"signature": map[string]interface{}{"content": sig, "publicKey": map[string]interface{}{"content": certPEM}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bundle cosignBundle
+	bundle.Payload.Body = base64.StdEncoding.EncodeToString(body)
+	bundle.Payload.IntegratedTime = now.Unix()
+	bundle.Payload.LogID = "log"
+	canonical, err := json.Marshal(map[string]interface{}{
+		"body":           bundle.Payload.Body,
+		"integratedTime": bundle.Payload.IntegratedTime,
+		"logIndex":       bundle.Payload.LogIndex,
+		"logID":          bundle.Payload.LogID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	setDigest := sha256.Sum256(canonical)
+	if bundle.SignedEntryTimestamp, err = ecdsa.SignASN1(rand.Reader, logKey, setDigest[:]); err != nil {
+		t.Fatal(err)
+	}
+	bundleJSON, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig, map[string]string{
+		CosignCertificateAnnotation: string(certPEM),
+		CosignBundleAnnotation:      string(bundleJSON),
+	}
+}
+
+func TestCosignVerifier_VerifyKeyless(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fulcio"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, &x509.Certificate{Subject: pkix.Name{CommonName: "fulcio"}}, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	logKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const subject = "release@min.io"
+	payload := []byte(`{"critical":{"image":{"docker-manifest-digest":"sha256:abc"},"type":"cosign container image signature"}}`)
+	sig, annotations := keylessSignature(t, ca, caKey, logKey, payload, subject)
+	otherSig, otherAnnotations := keylessSignature(t, ca, caKey, logKey, payload, subject)
+
+	// the bundle of another signature made by the same identity doesn't date this one
+	swappedBundle := map[string]string{
+		CosignCertificateAnnotation: annotations[CosignCertificateAnnotation],
+		CosignBundleAnnotation:      otherAnnotations[CosignBundleAnnotation],
+	}
+
+	identities := []KeylessIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: subject}}
+	tests := []struct {
+		name        string
+		logKey      crypto.PublicKey
+		identities  []KeylessIdentity
+		sig         []byte
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "signed by the identity",
+			logKey:      &logKey.PublicKey,
+			identities:  identities,
+			sig:         sig,
+			annotations: annotations,
+		},
+		{
+			name:        "no transparency log key",
+			identities:  identities,
+			sig:         sig,
+			annotations: annotations,
+			wantErr:     true,
+		},
+		{
+			name:        "signed by another identity",
+			logKey:      &logKey.PublicKey,
+			identities:  []KeylessIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "other@min.io"}},
+			sig:         sig,
+			annotations: annotations,
+			wantErr:     true,
+		},
+		{
+			name:        "bundle of another signature",
+			logKey:      &logKey.PublicKey,
+			identities:  identities,
+			sig:         sig,
+			annotations: swappedBundle,
+			wantErr:     true,
+		},
+		{
+			name:        "signature of another entry",
+			logKey:      &logKey.PublicKey,
+			identities:  identities,
+			sig:         otherSig,
+			annotations: annotations,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := &CosignVerifier{Roots: roots, TransparencyLogKey: tt.logKey, Identities: tt.identities}
+			if err := verifier.verifyKeyless(payload, tt.sig, tt.annotations); (err != nil) != tt.wantErr {
+				t.Errorf("verifyKeyless() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package signature
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	minisignAlgorithm       = "Ed"
+	minisignHashedAlgorithm = "ED"
+	minisignTrustedComment  = "trusted comment: "
+)
+
+// MinisignPublicKey is a minisign public key
+type MinisignPublicKey struct {
+	keyID [8]byte
+	key   ed25519.PublicKey
+}
+
+// ParseMinisignPublicKey parses a base64 encoded minisign public key, as found on the last line of a minisign public key file
+func ParseMinisignPublicKey(publicKey string) (*MinisignPublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid minisign public key: %w", err)
+	}
+	if len(raw) != 2+8+ed25519.PublicKeySize || string(raw[:2]) != minisignAlgorithm {
+		return nil, errors.New("invalid minisign public key")
+	}
+	pk := &MinisignPublicKey{key: ed25519.PublicKey(raw[10:])}
+	copy(pk.keyID[:], raw[2:10])
+	return pk, nil
+}
+
+// Verify checks the minisign signature of the message, including the signature of its trusted comment
+func (pk *MinisignPublicKey) Verify(message, signature []byte) error {
+	lines := strings.Split(strings.TrimSpace(string(signature)), "\n")
+	if len(lines) < 4 {
+		return errors.New("invalid minisign signature")
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil || len(sig) != 2+8+ed25519.SignatureSize {
+		return errors.New("invalid minisign signature")
+	}
+	if !bytes.Equal(sig[2:10], pk.keyID[:]) {
+		return errors.New("minisign signature was made with a different key")
+	}
+	switch string(sig[:2]) {
+	case minisignAlgorithm:
+	case minisignHashedAlgorithm:
+		hash := blake2b.Sum512(message)
+		message = hash[:]
+	default:
+		return errors.New("unsupported minisign signature algorithm")
+	}
+	if !ed25519.Verify(pk.key, message, sig[10:]) {
+		return errors.New("minisign signature verification failed")
+	}
+
+	trustedComment, ok := strings.CutPrefix(strings.TrimRight(lines[2], "\r"), minisignTrustedComment)
+	if !ok {
+		return errors.New("invalid minisign trusted comment")
+	}
+	globalSig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil || len(globalSig) != ed25519.SignatureSize {
+		return errors.New("invalid minisign trusted comment signature")
+	}
+	if !ed25519.Verify(pk.key, append(sig[10:], []byte(trustedComment)...), globalSig) {
+		return errors.New("minisign trusted comment verification failed")
+	}
+	return nil
+}
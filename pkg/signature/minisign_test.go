@@ -0,0 +1,107 @@
+// This file is part of MinIO Operator
+// Copyright (c) 2024 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// minisignKey generates a minisign key pair, returning the encoded public key
+func minisignKey(t *testing.T, keyID []byte) (string, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := append([]byte(minisignAlgorithm), keyID...)
+	return base64.StdEncoding.EncodeToString(append(raw, pub...)), priv
+}
+
+// minisign signs the message the way `minisign -S` does, with a prehashed signature
+func minisign(key ed25519.PrivateKey, keyID, message []byte, trustedComment string) []byte {
+	hash := blake2b.Sum512(message)
+	sig := ed25519.Sign(key, hash[:])
+	globalSig := ed25519.Sign(key, append(append([]byte{}, sig...), []byte(trustedComment)...))
+	raw := append(append([]byte(minisignHashedAlgorithm), keyID...), sig...)
+	return []byte(fmt.Sprintf("untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+		base64.StdEncoding.EncodeToString(raw), trustedComment, base64.StdEncoding.EncodeToString(globalSig)))
+}
+
+func TestMinisignPublicKey_Verify(t *testing.T) {
+	keyID := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	publicKey, privateKey := minisignKey(t, keyID)
+	_, otherKey := minisignKey(t, keyID)
+	message := []byte("minio binary")
+
+	pk, err := ParseMinisignPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := minisign(privateKey, keyID, message, "timestamp:1722659003")
+	tampered[len(tampered)-10] ^= 'a'
+
+	tests := []struct {
+		name      string
+		message   []byte
+		signature []byte
+		wantErr   bool
+	}{
+		{
+			name:      "valid signature",
+			message:   message,
+			signature: minisign(privateKey, keyID, message, "timestamp:1722659003"),
+		},
+		{
+			name:      "modified message",
+			message:   []byte("another binary"),
+			signature: minisign(privateKey, keyID, message, "timestamp:1722659003"),
+			wantErr:   true,
+		},
+		{
+			name:      "signed with another key",
+			message:   message,
+			signature: minisign(otherKey, keyID, message, "timestamp:1722659003"),
+			wantErr:   true,
+		},
+		{
+			name:      "different key id",
+			message:   message,
+			signature: minisign(privateKey, []byte{8, 7, 6, 5, 4, 3, 2, 1}, message, "timestamp:1722659003"),
+			wantErr:   true,
+		},
+		{
+			name:      "tampered trusted comment signature",
+			message:   message,
+			signature: tampered,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := pk.Verify(tt.message, tt.signature); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -72,6 +72,17 @@ func (c *Controller) BucketSrvHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if ok {
+		// the namespace may hold several tenants, only delete the service of a bucket of this tenant
+		svc, err := c.kubeClient.CoreV1().Services(namespace).Get(r.Context(), bucket, metav1.GetOptions{})
+		if err != nil {
+			klog.Errorf("failed to get service:%s for tenant:%s/%s, err:%s", bucket, namespace, name, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if owner := metav1.GetControllerOf(svc); owner == nil || owner.Name != name {
+			http.Error(w, fmt.Sprintf("service %s doesn't belong to tenant %s/%s", bucket, namespace, name), http.StatusForbidden)
+			return
+		}
 		if err = c.kubeClient.CoreV1().Services(namespace).Delete(r.Context(), bucket, metav1.DeleteOptions{}); err != nil {
 			klog.Errorf("failed to delete service:%s for tenant:%s/%s, err:%s", name, namespace, name, err)
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -104,6 +115,13 @@ func (c *Controller) BucketSrvHandler(w http.ResponseWriter, r *http.Request) {
 	service := services.ServiceForBucket(tenant, bucket)
 	_, err = c.kubeClient.CoreV1().Services(namespace).Create(r.Context(), service, metav1.CreateOptions{})
 	if err != nil && k8serrors.IsAlreadyExists(err) {
+		// another tenant of the namespace may have a bucket with the same name
+		existing, gerr := c.kubeClient.CoreV1().Services(namespace).Get(r.Context(), bucket, metav1.GetOptions{})
+		if gerr == nil && !metav1.IsControlledBy(existing, tenant) {
+			klog.Errorf("Bucket:%s service already exists for another tenant in namespace:%s", bucket, namespace)
+			http.Error(w, fmt.Sprintf("service %s belongs to another tenant of namespace %s", bucket, namespace), http.StatusConflict)
+			return
+		}
 		klog.Infof("Bucket:%s already exists for tenant:%s/%s err:%s ", bucket, namespace, name, err)
 		// This might be a previously failed bucket creation. The service is expected to be the same as the one
 		// already in place so clear the error.